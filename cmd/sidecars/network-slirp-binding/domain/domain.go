@@ -145,7 +145,7 @@ func networkCIDR(network *vmschema.Network) (string, error) {
 		return network.Pod.VMNetworkCIDR, nil
 	}
 
-	return domainschema.DefaultVMCIDR, nil
+	return vmispec.DefaultVMCIDR, nil
 }
 
 func ifacePorts(iface *vmschema.Interface) ([]vmschema.Port, error) {
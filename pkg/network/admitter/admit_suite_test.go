@@ -35,6 +35,7 @@ type stubClusterConfigChecker struct {
 	macvtapFeatureGateEnabled    bool
 	passtFeatureGateEnabled      bool
 	bindingPluginFGEnabled       bool
+	hotplugNICsFGEnabled         bool
 }
 
 func (s stubClusterConfigChecker) IsSlirpInterfaceEnabled() bool {
@@ -56,3 +57,7 @@ func (s stubClusterConfigChecker) PasstEnabled() bool {
 func (s stubClusterConfigChecker) NetworkBindingPlugingsEnabled() bool {
 	return s.bindingPluginFGEnabled
 }
+
+func (s stubClusterConfigChecker) HotplugNetworkInterfacesEnabled() bool {
+	return s.hotplugNICsFGEnabled
+}
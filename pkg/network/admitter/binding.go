@@ -21,13 +21,13 @@ package admitter
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 
-	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
@@ -38,11 +38,16 @@ func validateInterfaceBinding(
 	networksByName := vmispec.IndexNetworkSpecByName(spec.Networks)
 	for idx, iface := range spec.Domain.Devices.Interfaces {
 		causes = append(causes, validateInterfaceBindingExists(fieldPath, idx, iface)...)
-		causes = append(causes, validateMasqueradeBinding(fieldPath, idx, iface, networksByName[iface.Name])...)
+		if net, exists := networksByName[iface.Name]; exists {
+			causes = append(causes, validateBindingNetworkSource(fieldPath, idx, iface, net)...)
+		}
+		causes = append(causes, validateInterfaceModelBinding(fieldPath, idx, iface)...)
+		causes = append(causes, validateMasqueradeBinding(fieldPath, idx, iface)...)
 		causes = append(causes, validateBridgeBinding(fieldPath, idx, iface, networksByName[iface.Name], config)...)
 		causes = append(causes, validateBindingPlugin(fieldPath, idx, iface, config)...)
-		causes = append(causes, validateMacvtapBinding(fieldPath, idx, iface, networksByName[iface.Name], config)...)
-		causes = append(causes, validatePasstBinding(fieldPath, idx, iface, networksByName[iface.Name], config)...)
+		causes = append(causes, validateBindingPluginNameNotReserved(fieldPath, idx, iface)...)
+		causes = append(causes, validateMacvtapBinding(fieldPath, idx, iface, config)...)
+		causes = append(causes, validatePasstBinding(fieldPath, idx, iface, config)...)
 	}
 	return causes
 }
@@ -67,20 +72,82 @@ func hasInterfaceBindingMethod(iface v1.Interface) bool {
 		iface.InterfaceBindingMethod.DeprecatedPasst != nil
 }
 
-func validateMasqueradeBinding(fieldPath *field.Path, idx int, iface v1.Interface, net v1.Network) []metav1.StatusCause {
-	var causes []metav1.StatusCause
-	if iface.Masquerade != nil && net.Pod == nil {
-		causes = append(causes, metav1.StatusCause{
-			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "Masquerade interface only implemented with pod network",
-			Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
-		})
+type bindingNetworkSources struct {
+	bindingName string
+	usedBy      func(iface v1.Interface) bool
+	pod         bool
+	multus      bool
+	// emulated is set for bindings exposing a hypervisor emulated NIC to the guest, on which a model applies.
+	emulated bool
+}
+
+// bindingsNetworkSources lists the network sources each core binding can be connected to, and whether it emulates the guest NIC.
+var bindingsNetworkSources = []bindingNetworkSources{
+	{bindingName: "Masquerade", usedBy: func(iface v1.Interface) bool { return iface.Masquerade != nil }, pod: true, emulated: true},
+	{bindingName: "Slirp", usedBy: func(iface v1.Interface) bool { return iface.DeprecatedSlirp != nil }, pod: true, emulated: true},
+	{bindingName: "Passt", usedBy: func(iface v1.Interface) bool { return iface.DeprecatedPasst != nil }, pod: true, emulated: true},
+	{bindingName: "Macvtap", usedBy: func(iface v1.Interface) bool { return iface.DeprecatedMacvtap != nil }, multus: true, emulated: true},
+	{bindingName: "SR-IOV", usedBy: func(iface v1.Interface) bool { return iface.SRIOV != nil }, multus: true},
+	{bindingName: "Bridge", usedBy: func(iface v1.Interface) bool { return iface.Bridge != nil }, pod: true, multus: true, emulated: true},
+}
+
+func validateBindingNetworkSource(fieldPath *field.Path, idx int, iface v1.Interface, net v1.Network) []metav1.StatusCause {
+	for _, binding := range bindingsNetworkSources {
+		if !binding.usedBy(iface) {
+			continue
+		}
+		if (net.Pod != nil && !binding.pod) || (net.Multus != nil && !binding.multus) {
+			supportedNetwork := "pod network"
+			if binding.multus {
+				supportedNetwork = "Multus network"
+			}
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s interface only implemented with %s", binding.bindingName, supportedNetwork),
+				Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			}}
+		}
+	}
+	return nil
+}
+
+// validateInterfaceModelBinding rejects a model on interfaces which do not expose an emulated NIC to the guest:
+// SR-IOV passes a VF through and binding plugins define the guest NIC themselves.
+func validateInterfaceModelBinding(fieldPath *field.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if iface.Model == "" {
+		return nil
 	}
-	if iface.Masquerade != nil && link.IsReserved(iface.MacAddress) {
+	bindingName := "binding plugin"
+	if iface.Binding == nil {
+		bindingName = nonEmulatedCoreBindingName(iface)
+	}
+	if bindingName == "" {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s interface %q cannot set a model, it only applies to emulated bindings", bindingName, iface.Name),
+		Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("model").String(),
+	}}
+}
+
+func nonEmulatedCoreBindingName(iface v1.Interface) string {
+	for _, binding := range bindingsNetworkSources {
+		if binding.usedBy(iface) && !binding.emulated {
+			return binding.bindingName
+		}
+	}
+	return ""
+}
+
+func validateMasqueradeBinding(fieldPath *field.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if iface.Masquerade != nil && isMasqueradeBridgeMAC(iface.MacAddress) {
 		causes = append(causes, metav1.StatusCause{
-			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "The requested MAC address is reserved for the in-pod bridge. Please choose another one.",
-			Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("masquerade interface %q MAC address %s is reserved for the in-pod bridge, please choose another one",
+				iface.Name, iface.MacAddress),
+			Field: fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
 		})
 	}
 	return causes
@@ -99,6 +166,91 @@ func validateBridgeBinding(
 	return nil
 }
 
+// coreBindingName returns the name of the core binding the interface uses, empty for a binding plugin.
+func coreBindingName(iface v1.Interface) string {
+	for _, binding := range bindingsNetworkSources {
+		if binding.usedBy(iface) {
+			return binding.bindingName
+		}
+	}
+	return ""
+}
+
+// validateNetworkAttachmentBindingsCompatible rejects interfaces connecting the same network attachment with
+// different core bindings. Each binding requires its own CNI (e.g. SR-IOV or bridge), so one of them is wrong.
+func validateNetworkAttachmentBindingsCompatible(fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	type nadBinding struct {
+		ifaceName   string
+		bindingName string
+	}
+	var causes []metav1.StatusCause
+	networksByName := vmispec.IndexNetworkSpecByName(spec.Networks)
+	firstBindingByNad := map[string]nadBinding{}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		network, exists := networksByName[iface.Name]
+		bindingName := coreBindingName(iface)
+		if !exists || network.Multus == nil || bindingName == "" {
+			continue
+		}
+		first, exists := firstBindingByNad[network.Multus.NetworkName]
+		if !exists {
+			firstBindingByNad[network.Multus.NetworkName] = nadBinding{ifaceName: iface.Name, bindingName: bindingName}
+			continue
+		}
+		if first.bindingName != bindingName {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s interface %q and %s interface %q cannot share the network attachment %q",
+					bindingName, iface.Name, first.bindingName, first.ifaceName, network.Multus.NetworkName),
+				Field: fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validateBridgeOnPodNetwork warns on bridge interfaces connected to the pod network, which hands the pod IP over
+// to the VM while the pod network CNI usually filters frames from MAC addresses it did not learn.
+func validateBridgeOnPodNetwork(fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	podNetwork := vmispec.LookupPodNetwork(spec.Networks)
+	if podNetwork == nil {
+		return nil
+	}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Name == podNetwork.Name && iface.Bridge != nil {
+			return []metav1.StatusCause{{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("bridge interface %q takes over the pod IP, the pod network may not learn the VM MAC address "+
+					"and drop its traffic, consider using masquerade", iface.Name),
+				Field: fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("bridge").String(),
+			}}
+		}
+	}
+	return nil
+}
+
+// reservedBindingPluginNames are the core bindings, which a binding plugin may not shadow.
+// The deprecated passt, macvtap and slirp core bindings are not reserved, they are shipped as binding plugins of the same name.
+var reservedBindingPluginNames = map[string]struct{}{
+	"bridge":     {},
+	"masquerade": {},
+	"sriov":      {},
+}
+
+func validateBindingPluginNameNotReserved(fieldPath *field.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if iface.Binding == nil {
+		return nil
+	}
+	if _, reserved := reservedBindingPluginNames[strings.ToLower(iface.Binding.Name)]; reserved {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("binding plugin name %q is reserved for the core %s binding", iface.Binding.Name, strings.ToLower(iface.Binding.Name)),
+			Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("binding", "name").String(),
+		}}
+	}
+	return nil
+}
+
 func validateBindingPlugin(fieldPath *field.Path, idx int, iface v1.Interface, config clusterConfigChecker) []metav1.StatusCause {
 	if iface.Binding != nil && !config.NetworkBindingPlugingsEnabled() {
 		return []metav1.StatusCause{{
@@ -20,6 +20,8 @@
 package admitter_test
 
 import (
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -64,6 +66,26 @@ var _ = Describe("Validating network binding combinations", func() {
 		Expect(validator.Validate()).To(BeEmpty())
 	})
 
+	DescribeTable("network interface plugin binding name", func(pluginName string, expectedCauses []metav1.StatusCause) {
+		vm := api.NewMinimalVMI("testvm")
+		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:    "foo",
+			Binding: &v1.PluginBinding{Name: pluginName},
+		}}
+		vm.Spec.Networks = []v1.Network{{Name: "foo", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+		clusterConfig := stubClusterConfigChecker{bindingPluginFGEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vm.Spec, clusterConfig)
+		Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+	},
+		Entry("should be rejected when it shadows a core binding", "bridge", []metav1.StatusCause{{
+			Type:    "FieldValueInvalid",
+			Message: "binding plugin name \"bridge\" is reserved for the core bridge binding",
+			Field:   "fake.domain.devices.interfaces[0].binding.name",
+		}}),
+		Entry("should be accepted when it is not reserved", "myplugin", nil),
+		Entry("should be accepted for a plugin replacing a deprecated core binding", "passt", nil),
+	)
+
 	It("network interface has only binding method", func() {
 		vm := api.NewMinimalVMI("testvm")
 		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
@@ -100,12 +122,12 @@ var _ = Describe("Validating core binding", func() {
 		}))
 	})
 
-	It("should reject a masquerade interface with a specified reserved MAC address", func() {
+	DescribeTable("should reject a masquerade interface with a specified reserved MAC address", func(macAddress string) {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		spec.Domain.Devices.Interfaces = []v1.Interface{{
 			Name:                   "default",
 			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
-			MacAddress:             "02:00:00:00:00:00",
+			MacAddress:             macAddress,
 		}}
 		spec.Networks = []v1.Network{
 			{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
@@ -115,10 +137,30 @@ var _ = Describe("Validating core binding", func() {
 		causes := validator.Validate()
 
 		Expect(causes).To(ConsistOf(metav1.StatusCause{
-			Type:    "FieldValueInvalid",
-			Message: "The requested MAC address is reserved for the in-pod bridge. Please choose another one.",
-			Field:   "fake.domain.devices.interfaces[0].macAddress",
+			Type: "FieldValueInvalid",
+			Message: fmt.Sprintf(
+				"masquerade interface \"default\" MAC address %s is reserved for the in-pod bridge, please choose another one", macAddress,
+			),
+			Field: "fake.domain.devices.interfaces[0].macAddress",
 		}))
+	},
+		Entry("in colon notation", "02:00:00:00:00:00"),
+		Entry("in dash notation", "02-00-00-00-00-00"),
+	)
+
+	It("should accept a masquerade interface with a non reserved MAC address", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			MacAddress:             "02:00:00:00:00:01",
+		}}
+		spec.Networks = []v1.Network{
+			{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+		}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
 	})
 
 	It("should reject a bridge interface on a pod network when it is not permitted", func() {
@@ -136,6 +178,65 @@ var _ = Describe("Validating core binding", func() {
 		}))
 	})
 
+	It("should warn on a bridge interface on the pod network", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true},
+		)
+		Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+			Type: "FieldValueInvalid",
+			Message: "bridge interface \"default\" takes over the pod IP, the pod network may not learn the VM MAC address " +
+				"and drop its traffic, consider using masquerade",
+			Field: "fake.domain.devices.interfaces[0].bridge",
+		}))
+	})
+
+	It("should not warn on a bridge interface on a secondary network", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "red",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name:          "red",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	})
+
+	DescribeTable("network attachment shared by interfaces", func(bindings []v1.InterfaceBindingMethod, expectedCauses []metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		for i, binding := range bindings {
+			name := fmt.Sprintf("net%d", i+1)
+			spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{Name: name, InterfaceBindingMethod: binding})
+			spec.Networks = append(spec.Networks, v1.Network{
+				Name:          name,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "shared-net"}},
+			})
+		}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+	},
+		Entry("should be rejected with SR-IOV and bridge bindings",
+			[]v1.InterfaceBindingMethod{{SRIOV: &v1.InterfaceSRIOV{}}, {Bridge: &v1.InterfaceBridge{}}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "Bridge interface \"net2\" and SR-IOV interface \"net1\" cannot share the network attachment \"shared-net\"",
+				Field:   "fake.domain.devices.interfaces[1].name",
+			}},
+		),
+		Entry("should be accepted with the same binding",
+			[]v1.InterfaceBindingMethod{{Bridge: &v1.InterfaceBridge{}}, {Bridge: &v1.InterfaceBridge{}}},
+			nil,
+		),
+	)
+
 	It("should reject networks with a binding plugin interface when network-binding-plugin feature gate disabled", func() {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		spec.Domain.Devices.Interfaces = []v1.Interface{{
@@ -153,4 +254,109 @@ var _ = Describe("Validating core binding", func() {
 			Field:   "fake.domain.devices.interfaces[0].name",
 		}))
 	})
+
+	DescribeTable("should reject a binding connected to an incompatible network source",
+		func(binding v1.InterfaceBindingMethod, networkSource v1.NetworkSource, expectedMessage string) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "net1", InterfaceBindingMethod: binding}}
+			spec.Networks = []v1.Network{{Name: "net1", NetworkSource: networkSource}}
+
+			clusterConfig := stubClusterConfigChecker{
+				slirpEnabled:              true,
+				macvtapFeatureGateEnabled: true,
+				passtFeatureGateEnabled:   true,
+			}
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+			Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: expectedMessage,
+				Field:   "fake.domain.devices.interfaces[0].name",
+			}))
+		},
+		Entry("masquerade on a Multus network",
+			v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net1"}},
+			"Masquerade interface only implemented with pod network",
+		),
+		Entry("slirp on a Multus network",
+			v1.InterfaceBindingMethod{DeprecatedSlirp: &v1.DeprecatedInterfaceSlirp{}},
+			v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net1"}},
+			"Slirp interface only implemented with pod network",
+		),
+		Entry("passt on a Multus network",
+			v1.InterfaceBindingMethod{DeprecatedPasst: &v1.DeprecatedInterfacePasst{}},
+			v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net1"}},
+			"Passt interface only implemented with pod network",
+		),
+		Entry("macvtap on a pod network",
+			v1.InterfaceBindingMethod{DeprecatedMacvtap: &v1.DeprecatedInterfaceMacvtap{}},
+			v1.NetworkSource{Pod: &v1.PodNetwork{}},
+			"Macvtap interface only implemented with Multus network",
+		),
+		Entry("SR-IOV on a pod network",
+			v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			v1.NetworkSource{Pod: &v1.PodNetwork{}},
+			"SR-IOV interface only implemented with Multus network",
+		),
+	)
+
+	DescribeTable("should accept a bridge binding connected to", func(networkSource v1.NetworkSource) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "net1",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}}
+		spec.Networks = []v1.Network{{Name: "net1", NetworkSource: networkSource}}
+
+		clusterConfig := stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("a pod network", v1.NetworkSource{Pod: &v1.PodNetwork{}}),
+		Entry("a Multus network", v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net1"}}),
+	)
+
+	Context("interface model", func() {
+		multusNetwork := v1.Network{Name: "net1", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net1"}}}
+
+		DescribeTable("should accept a model on an emulated binding", func(binding v1.InterfaceBindingMethod) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "net1",
+				Model:                  "e1000",
+				InterfaceBindingMethod: binding,
+			}}
+			spec.Networks = []v1.Network{multusNetwork}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{macvtapFeatureGateEnabled: true})
+			Expect(validator.Validate()).To(BeEmpty())
+		},
+			Entry("bridge", v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}),
+			Entry("macvtap, rendered as an emulated tap NIC", v1.InterfaceBindingMethod{DeprecatedMacvtap: &v1.DeprecatedInterfaceMacvtap{}}),
+		)
+
+		DescribeTable("should reject a model on a non emulated binding", func(iface v1.Interface, expectedMessage string) {
+			iface.Name = "net1"
+			iface.Model = "virtio"
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+			spec.Networks = []v1.Network{multusNetwork}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{bindingPluginFGEnabled: true})
+			Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: expectedMessage,
+				Field:   "fake.domain.devices.interfaces[0].model",
+			}))
+		},
+			Entry("SR-IOV",
+				v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}},
+				"SR-IOV interface \"net1\" cannot set a model, it only applies to emulated bindings",
+			),
+			Entry("binding plugin",
+				v1.Interface{Binding: &v1.PluginBinding{Name: "custom"}},
+				"binding plugin interface \"net1\" cannot set a model, it only applies to emulated bindings",
+			),
+		)
+	})
 })
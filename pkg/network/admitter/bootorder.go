@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validateFirstBootInterfaceBinding(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	firstBootIdx := -1
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.BootOrder == nil {
+			continue
+		}
+		if firstBootIdx == -1 || *iface.BootOrder < *spec.Domain.Devices.Interfaces[firstBootIdx].BootOrder {
+			firstBootIdx = idx
+		}
+	}
+	if firstBootIdx == -1 {
+		return nil
+	}
+
+	iface := spec.Domain.Devices.Interfaces[firstBootIdx]
+	if !isBootCapableBinding(iface) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interface %q has the lowest boot order but its binding does not support network boot", iface.Name),
+			Field:   field.Child("domain", "devices", "interfaces").Index(firstBootIdx).Child("bootOrder").String(),
+		}}
+	}
+	return nil
+}
+
+func isBootCapableBinding(iface v1.Interface) bool {
+	return iface.SRIOV == nil
+}
+
+// validateSRIOVBootOrder rejects a boot order on SR-IOV interfaces, booting from a passthrough VF depends on
+// its option ROM which KubeVirt does not guarantee.
+func validateSRIOVBootOrder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.SRIOV != nil && iface.BootOrder != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("SR-IOV interface %q cannot set a boot order, network boot is not supported on passthrough devices", iface.Name),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("bootOrder").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validateInterfaceBootOrderWithKernelBoot warns on interfaces setting a boot order while the VMI boots
+// a kernel directly, so the firmware never attempts a network boot.
+func validateInterfaceBootOrderWithKernelBoot(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if spec.Domain.Firmware == nil || spec.Domain.Firmware.KernelBoot == nil || spec.Domain.Firmware.KernelBoot.Container == nil {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.BootOrder != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q boot order is ignored since the VMI boots a kernel directly", iface.Name),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("bootOrder").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validateBootOrderContiguous warns when the boot order values set across disks and interfaces have gaps.
+// Gaps are legal, but usually come from an interface or disk added or removed without renumbering the rest.
+func validateBootOrderContiguous(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	bootOrders := map[uint]struct{}{}
+	interfaceBootOrderSet := false
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		if iface.BootOrder != nil {
+			bootOrders[*iface.BootOrder] = struct{}{}
+			interfaceBootOrderSet = true
+		}
+	}
+	if !interfaceBootOrderSet {
+		return nil
+	}
+	for _, disk := range spec.Domain.Devices.Disks {
+		if disk.BootOrder != nil {
+			bootOrders[*disk.BootOrder] = struct{}{}
+		}
+	}
+
+	sortedBootOrders := make([]int, 0, len(bootOrders))
+	for bootOrder := range bootOrders {
+		sortedBootOrders = append(sortedBootOrders, int(bootOrder))
+	}
+	sort.Ints(sortedBootOrders)
+	for i := 1; i < len(sortedBootOrders); i++ {
+		if sortedBootOrders[i]-sortedBootOrders[i-1] > 1 {
+			return []metav1.StatusCause{{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("disks and interfaces boot order values %v have gaps, consider numbering them contiguously",
+					sortedBootOrders),
+				Field: field.Child("domain", "devices").String(),
+			}}
+		}
+	}
+	return nil
+}
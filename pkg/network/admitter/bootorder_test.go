@@ -0,0 +1,213 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating interface boot order", func() {
+	It("should accept a boot order on a bridge interface", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "red",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				BootOrder:              pointer.P(uint(1)),
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+		)
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject a boot order on an SR-IOV interface", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "red",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				BootOrder:              pointer.P(uint(2)),
+			}),
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "blue",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+				BootOrder:              pointer.P(uint(1)),
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+			libvmi.WithNetwork(libvmi.MultusNetwork("blue", "blue-net")),
+		)
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "SR-IOV interface \"blue\" cannot set a boot order, network boot is not supported on passthrough devices",
+			Field:   "fake.domain.devices.interfaces[1].bootOrder",
+		}))
+	})
+
+	It("should reject a boot order on the only SR-IOV interface booting", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "blue",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+				BootOrder:              pointer.P(uint(1)),
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("blue", "blue-net")),
+		)
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "SR-IOV interface \"blue\" cannot set a boot order, network boot is not supported on passthrough devices",
+			Field:   "fake.domain.devices.interfaces[0].bootOrder",
+		}))
+	})
+
+	It("should not warn when the lowest boot order is set on a bridge interface", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "red",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				BootOrder:              pointer.P(uint(1)),
+			}),
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "blue",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+				BootOrder:              pointer.P(uint(2)),
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+			libvmi.WithNetwork(libvmi.MultusNetwork("blue", "blue-net")),
+		)
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	})
+
+	It("should warn when the lowest boot order is set on an SR-IOV interface", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "red",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				BootOrder:              pointer.P(uint(2)),
+			}),
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "blue",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+				BootOrder:              pointer.P(uint(1)),
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+			libvmi.WithNetwork(libvmi.MultusNetwork("blue", "blue-net")),
+		)
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface \"blue\" has the lowest boot order but its binding does not support network boot",
+			Field:   "fake.domain.devices.interfaces[1].bootOrder",
+		}))
+	})
+
+	Context("with kernel boot", func() {
+		It("should warn when an interface sets a boot order", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(v1.Interface{
+					Name:                   "red",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					BootOrder:              pointer.P(uint(1)),
+				}),
+				libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+			)
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				KernelBoot: &v1.KernelBoot{Container: &v1.KernelBootContainer{Image: "kernel-image"}},
+			}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "interface \"red\" boot order is ignored since the VMI boots a kernel directly",
+				Field:   "fake.domain.devices.interfaces[0].bootOrder",
+			}))
+		})
+
+		It("should not warn when no interface sets a boot order", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(v1.Interface{
+					Name:                   "red",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				}),
+				libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+			)
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				KernelBoot: &v1.KernelBoot{Container: &v1.KernelBootContainer{Image: "kernel-image"}},
+			}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+	})
+
+	Context("across disks and interfaces", func() {
+		It("should not warn when the boot order values are contiguous", func() {
+			vmi := libvmi.New(
+				libvmi.WithContainerDisk("disk0", "disk-image"),
+				libvmi.WithInterface(v1.Interface{
+					Name:                   "red",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					BootOrder:              pointer.P(uint(2)),
+				}),
+				libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+			)
+			vmi.Spec.Domain.Devices.Disks[0].BootOrder = pointer.P(uint(1))
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+
+		It("should warn when the boot order values have gaps", func() {
+			vmi := libvmi.New(
+				libvmi.WithContainerDisk("disk0", "disk-image"),
+				libvmi.WithInterface(v1.Interface{
+					Name:                   "red",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					BootOrder:              pointer.P(uint(3)),
+				}),
+				libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+			)
+			vmi.Spec.Domain.Devices.Disks[0].BootOrder = pointer.P(uint(1))
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "disks and interfaces boot order values [1 3] have gaps, consider numbering them contiguously",
+				Field:   "fake.domain.devices",
+			}))
+		})
+	})
+})
@@ -0,0 +1,255 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// MacRange is an inclusive range of MAC addresses.
+type MacRange struct {
+	Start net.HardwareAddr
+	End   net.HardwareAddr
+}
+
+// Contains reports whether the MAC address is within the range, bounds included.
+func (r MacRange) Contains(mac net.HardwareAddr) bool {
+	return bytes.Compare(mac, r.Start) >= 0 && bytes.Compare(mac, r.End) <= 0
+}
+
+// String returns the range as "start-end", as reported in the causes.
+func (r MacRange) String() string {
+	return fmt.Sprintf("%s-%s", r.Start, r.End)
+}
+
+// WithReservedMacRanges sets MAC address ranges which are reserved for internal use and may not be requested.
+func WithReservedMacRanges(ranges ...MacRange) option {
+	return func(v *Validator) {
+		v.reservedMacRanges = ranges
+	}
+}
+
+func validateMacAddressNotReserved(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, reservedRanges []MacRange) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.MacAddress == "" {
+			continue
+		}
+		mac, err := net.ParseMAC(iface.MacAddress)
+		if err != nil {
+			continue
+		}
+		for _, reservedRange := range reservedRanges {
+			if reservedRange.Contains(mac) {
+				causes = append(causes, metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("interface %q MAC address %s is within the reserved range %s",
+						iface.Name, iface.MacAddress, reservedRange),
+					Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+				})
+				break
+			}
+		}
+	}
+	return causes
+}
+
+// validateMacAddressUniquePerNetworkAttachment rejects interfaces requesting the same MAC address on the same
+// network attachment. Only the admitted VMI is checked, collisions with other VMIs on the attachment are not visible here.
+func validateMacAddressUniquePerNetworkAttachment(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	type nadMac struct {
+		networkName string
+		mac         string
+	}
+	var causes []metav1.StatusCause
+	networksByName := vmispec.IndexNetworkSpecByName(spec.Networks)
+	ifaceNameByNadMac := map[nadMac]string{}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		network, exists := networksByName[iface.Name]
+		if !exists || network.Multus == nil || iface.MacAddress == "" {
+			continue
+		}
+		mac, err := net.ParseMAC(iface.MacAddress)
+		if err != nil {
+			continue
+		}
+		key := nadMac{networkName: network.Multus.NetworkName, mac: mac.String()}
+		if ownerName, exists := ifaceNameByNadMac[key]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("interface %q MAC address %s is already used by interface %q on network attachment %q",
+					iface.Name, iface.MacAddress, ownerName, key.networkName),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+			})
+			continue
+		}
+		ifaceNameByNadMac[key] = iface.Name
+	}
+	return causes
+}
+
+// WithStrictMacAddressCase requires MAC addresses to be set in lowercase, KubeVirt's canonical form.
+func WithStrictMacAddressCase() option {
+	return func(v *Validator) {
+		v.strictMacAddressCase = true
+	}
+}
+
+func validateMacAddressLowercase(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, strict bool) []metav1.StatusCause {
+	if !strict {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.MacAddress != strings.ToLower(iface.MacAddress) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q MAC address %s must be lowercase", iface.Name, iface.MacAddress),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// documentationMacRange is the IANA unicast block reserved for documentation (RFC 7042).
+var documentationMacRange = MacRange{
+	Start: net.HardwareAddr{0x00, 0x00, 0x5e, 0x00, 0x53, 0x00},
+	End:   net.HardwareAddr{0x00, 0x00, 0x5e, 0x00, 0x53, 0xff},
+}
+
+// placeholderMacAddresses are example MAC addresses commonly copied from manifests and tutorials.
+var placeholderMacAddresses = map[string]struct{}{
+	"00:11:22:33:44:55": {},
+	"12:34:56:78:90:ab": {},
+	"12:34:56:78:9a:bc": {},
+	"aa:bb:cc:dd:ee:ff": {},
+}
+
+// WithPlaceholderMacAddressWarning warns on MAC addresses taken from documentation or well known examples,
+// which are likely to be reused across VMs and collide.
+func WithPlaceholderMacAddressWarning() option {
+	return func(v *Validator) {
+		v.warnPlaceholderMacAddress = true
+	}
+}
+
+func validateMacAddressNotPlaceholder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, enabled bool) []metav1.StatusCause {
+	if !enabled {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.MacAddress == "" {
+			continue
+		}
+		mac, err := net.ParseMAC(iface.MacAddress)
+		if err != nil {
+			continue
+		}
+		if _, isPlaceholder := placeholderMacAddresses[mac.String()]; isPlaceholder || documentationMacRange.Contains(mac) {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q MAC address %s is a well known example address and may collide with other VMs",
+					iface.Name, iface.MacAddress),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// foreignHypervisorMacOUIs maps the OUIs other virtualization platforms assign MAC addresses from.
+var foreignHypervisorMacOUIs = map[string]string{
+	"00:05:69": "VMware",
+	"00:0c:29": "VMware",
+	"00:50:56": "VMware",
+	"00:15:5d": "Hyper-V",
+	"00:16:3e": "Xen",
+	"08:00:27": "VirtualBox",
+}
+
+// WithForeignHypervisorMacAddressWarning warns on MAC addresses taken from another virtualization platform OUI,
+// which are likely copied over from an imported VM and may collide with it.
+func WithForeignHypervisorMacAddressWarning() option {
+	return func(v *Validator) {
+		v.warnForeignHypervisorMacAddress = true
+	}
+}
+
+func validateMacAddressNotForeignHypervisor(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, enabled bool) []metav1.StatusCause {
+	if !enabled {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.MacAddress == "" {
+			continue
+		}
+		mac, err := net.ParseMAC(iface.MacAddress)
+		if err != nil || len(mac) != 6 {
+			continue
+		}
+		if platform, isForeign := foreignHypervisorMacOUIs[mac[:3].String()]; isForeign {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q MAC address %s belongs to the %s OUI and may collide with the VM it was copied from",
+					iface.Name, iface.MacAddress, platform),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// WithMacPoolRemainingCapacity sets the number of MAC addresses the cluster MAC pool can still assign.
+func WithMacPoolRemainingCapacity(remainingCapacity int) option {
+	return func(v *Validator) {
+		v.macPoolRemainingCapacity = &remainingCapacity
+	}
+}
+
+func validateMacPoolCapacity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, remainingCapacity *int) []metav1.StatusCause {
+	if remainingCapacity == nil {
+		return nil
+	}
+	autoAssigned := vmispec.FilterInterfacesSpec(spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
+		return iface.MacAddress == ""
+	})
+	if len(autoAssigned) > *remainingCapacity {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%d interfaces need a MAC address assigned, more than the %d left in the cluster MAC pool",
+				len(autoAssigned), *remainingCapacity),
+			Field: field.Child("domain", "devices", "interfaces").String(),
+		}}
+	}
+	return nil
+}
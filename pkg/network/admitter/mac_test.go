@@ -0,0 +1,336 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	"fmt"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface MAC address", func() {
+	Context("with reserved MAC ranges", func() {
+		reservedRange := admitter.MacRange{
+			Start: mustParseMAC("02:aa:00:00:00:00"),
+			End:   mustParseMAC("02:aa:00:00:ff:ff"),
+		}
+
+		It("should reject a MAC address inside a reserved range", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].MacAddress = "02:AA:00:00:12:34"
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithReservedMacRanges(reservedRange),
+			)
+			Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "interface \"default\" MAC address 02:AA:00:00:12:34 is within the reserved range 02:aa:00:00:00:00-02:aa:00:00:ff:ff",
+				Field:   "fake.domain.devices.interfaces[0].macAddress",
+			}))
+		})
+
+		It("should accept a MAC address outside the reserved ranges", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].MacAddress = "02:aa:00:01:00:00"
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithReservedMacRanges(reservedRange),
+			)
+			Expect(validator.Validate()).To(BeEmpty())
+		})
+	})
+
+	Context("with interfaces connected to the same network attachment", func() {
+		It("should reject identical MAC addresses", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{
+				{
+					Name:                   "red",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					MacAddress:             "02:00:00:00:00:01",
+				},
+				{
+					Name:                   "blue",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					MacAddress:             "02-00-00-00-00-01",
+				},
+			}
+			spec.Networks = []v1.Network{
+				{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "same-nad"}}},
+				{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "same-nad"}}},
+			}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueDuplicate",
+				Message: "interface \"blue\" MAC address 02-00-00-00-00-01 is already used by interface \"red\" on network attachment \"same-nad\"",
+				Field:   "fake.domain.devices.interfaces[1].macAddress",
+			}))
+		})
+
+		It("should reject the same MAC address on the same network attachment once per duplicate", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			for _, name := range []string{"red", "blue", "green"} {
+				spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+					Name:                   name,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					MacAddress:             "02:00:00:00:00:01",
+				})
+				spec.Networks = append(spec.Networks, v1.Network{
+					Name: name, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "same-nad"}},
+				})
+			}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(ConsistOf(
+				metav1.StatusCause{
+					Type:    "FieldValueDuplicate",
+					Message: "interface \"blue\" MAC address 02:00:00:00:00:01 is already used by interface \"red\" on network attachment \"same-nad\"",
+					Field:   "fake.domain.devices.interfaces[1].macAddress",
+				},
+				metav1.StatusCause{
+					Type:    "FieldValueDuplicate",
+					Message: "interface \"green\" MAC address 02:00:00:00:00:01 is already used by interface \"red\" on network attachment \"same-nad\"",
+					Field:   "fake.domain.devices.interfaces[2].macAddress",
+				},
+			))
+		})
+
+		DescribeTable("should accept", func(redMac, blueMac, blueNetworkName string) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{
+				{
+					Name:                   "red",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					MacAddress:             redMac,
+				},
+				{
+					Name:                   "blue",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					MacAddress:             blueMac,
+				},
+			}
+			spec.Networks = []v1.Network{
+				{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "same-nad"}}},
+				{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: blueNetworkName}}},
+			}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(BeEmpty())
+		},
+			Entry("distinct MAC addresses", "02:00:00:00:00:01", "02:00:00:00:00:02", "same-nad"),
+			Entry("an explicit and an automatic MAC address", "02:00:00:00:00:01", "", "same-nad"),
+			Entry("the same MAC address on different network attachments", "02:00:00:00:00:01", "02:00:00:00:00:01", "other-nad"),
+		)
+	})
+
+	Context("in strict case mode", func() {
+		It("should reject an uppercase MAC address", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].MacAddress = "02:AA:00:00:12:34"
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithStrictMacAddressCase())
+			Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "interface \"default\" MAC address 02:AA:00:00:12:34 must be lowercase",
+				Field:   "fake.domain.devices.interfaces[0].macAddress",
+			}))
+		})
+
+		It("should accept a lowercase MAC address", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].MacAddress = "02:aa:00:00:12:34"
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithStrictMacAddressCase())
+			Expect(validator.Validate()).To(BeEmpty())
+		})
+	})
+
+	Context("with placeholder MAC address warning", func() {
+		DescribeTable("should warn on", func(macAddress string) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].MacAddress = macAddress
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithPlaceholderMacAddressWarning(),
+			)
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type: "FieldValueInvalid",
+				Message: fmt.Sprintf(
+					"interface \"default\" MAC address %s is a well known example address and may collide with other VMs", macAddress,
+				),
+				Field: "fake.domain.devices.interfaces[0].macAddress",
+			}))
+		},
+			Entry("a placeholder MAC address", "00:11:22:33:44:55"),
+			Entry("an uppercase placeholder MAC address", "AA:BB:CC:DD:EE:FF"),
+			Entry("a MAC address from the documentation range", "00:00:5e:00:53:01"),
+		)
+
+		It("should not warn on a random MAC address", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].MacAddress = "02:7c:3e:91:a4:d2"
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithPlaceholderMacAddressWarning(),
+			)
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+	})
+
+	Context("with foreign hypervisor MAC address warning", func() {
+		DescribeTable("should warn on", func(macAddress, platform string) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].MacAddress = macAddress
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithForeignHypervisorMacAddressWarning(),
+			)
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type: "FieldValueInvalid",
+				Message: fmt.Sprintf(
+					"interface \"default\" MAC address %s belongs to the %s OUI and may collide with the VM it was copied from",
+					macAddress, platform,
+				),
+				Field: "fake.domain.devices.interfaces[0].macAddress",
+			}))
+		},
+			Entry("a VMware MAC address", "00:50:56:a1:b2:c3", "VMware"),
+			Entry("an uppercase Hyper-V MAC address", "00:15:5D:01:02:03", "Hyper-V"),
+		)
+
+		It("should not warn on a KVM MAC address", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].MacAddress = "52:54:00:a1:b2:c3"
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithForeignHypervisorMacAddressWarning(),
+			)
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+
+		It("should not warn when the warning is not requested", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].MacAddress = "00:50:56:a1:b2:c3"
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+	})
+
+	Context("with the cluster MAC pool remaining capacity", func() {
+		It("should warn when more interfaces need a MAC address than the pool has left", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			for _, name := range []string{"red", "blue", "green"} {
+				spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+					Name:                   name,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				})
+				spec.Networks = append(spec.Networks, v1.Network{
+					Name: name, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: name + "-net"}},
+				})
+			}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithMacPoolRemainingCapacity(2),
+			)
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "3 interfaces need a MAC address assigned, more than the 2 left in the cluster MAC pool",
+				Field:   "fake.domain.devices.interfaces",
+			}))
+		})
+
+		DescribeTable("should not warn", func(remainingCapacity int, redMac, blueMac string) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}, MacAddress: redMac},
+				{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}, MacAddress: blueMac},
+			}
+			spec.Networks = []v1.Network{
+				{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+				{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}},
+			}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithMacPoolRemainingCapacity(remainingCapacity),
+			)
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		},
+			Entry("when the interfaces fit the remaining capacity", 2, "", ""),
+			Entry("when the interfaces set their MAC address", 0, "02:00:00:00:00:01", "02:00:00:00:00:02"),
+		)
+	})
+
+	It("should not warn on a placeholder MAC address when the warning is not requested", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].MacAddress = "00:11:22:33:44:55"
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	})
+
+	It("should accept an uppercase MAC address when not in strict case mode", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].MacAddress = "02:AA:00:00:12:34"
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+})
+
+func mustParseMAC(s string) net.HardwareAddr {
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		panic(err)
+	}
+	return mac
+}
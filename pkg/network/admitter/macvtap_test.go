@@ -51,6 +51,29 @@ var _ = Describe("Validating macvtap core binding", func() {
 		}))
 	})
 
+	It("should reject a macvtap interface on a multus network setting the masquerade CIDRs", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{DeprecatedMacvtap: &v1.DeprecatedInterfaceMacvtap{}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name: "default",
+			NetworkSource: v1.NetworkSource{
+				Multus: &v1.MultusNetwork{NetworkName: "test"},
+				Pod:    &v1.PodNetwork{VMNetworkCIDR: "10.10.10.0/24"},
+			},
+		}}
+
+		clusterConfig := stubClusterConfigChecker{macvtapFeatureGateEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+		Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+			Type:    "FieldValueRequired",
+			Message: "should have only one network type",
+			Field:   "fake.networks[0]",
+		}))
+	})
+
 	It("should reject a macvtap interface on a multus network when the feature is inactive", func() {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		spec.Domain.Devices.Interfaces = []v1.Interface{{
@@ -0,0 +1,284 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/istio"
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// WithClusterIPFamilies sets the IP families supported by the cluster pod network.
+func WithClusterIPFamilies(ipFamilies ...k8scorev1.IPFamily) option {
+	return func(v *Validator) {
+		v.clusterIPFamilies = ipFamilies
+	}
+}
+
+// validateMasqueradeDualStackCIDRs warns when a masquerade interface sets the CIDR of a single family, leaving out
+// another family the cluster supports to its default CIDR. When no CIDR is set, the defaults are used on purpose.
+func validateMasqueradeDualStackCIDRs(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, clusterIPFamilies []k8scorev1.IPFamily,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, network := range spec.Networks {
+		iface := vmispec.LookupInterfaceByName(spec.Domain.Devices.Interfaces, network.Name)
+		if network.Pod == nil || iface == nil || iface.Masquerade == nil {
+			continue
+		}
+		if network.Pod.VMNetworkCIDR == "" && network.Pod.VMIPv6NetworkCIDR == "" {
+			continue
+		}
+		cidrFieldByFamily := map[k8scorev1.IPFamily]string{
+			k8scorev1.IPv4Protocol: "vmNetworkCIDR",
+			k8scorev1.IPv6Protocol: "vmIPv6NetworkCIDR",
+		}
+		cidrByFamily := map[k8scorev1.IPFamily]string{
+			k8scorev1.IPv4Protocol: network.Pod.VMNetworkCIDR,
+			k8scorev1.IPv6Protocol: network.Pod.VMIPv6NetworkCIDR,
+		}
+		for _, family := range clusterIPFamilies {
+			if cidrByFamily[family] == "" {
+				causes = append(causes, metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("masquerade interface %q sets no %s CIDR although the cluster supports it, the default CIDR is used",
+						iface.Name, family),
+					Field: field.Child("networks").Index(idx).Child("pod", cidrFieldByFamily[family]).String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+// validateMasqueradeDNSNameserversFamilies warns when none of the DNS config nameservers can be reached over
+// the IP families of the masquerade interface CIDRs, e.g. IPv4 only nameservers with an IPv6 only VM network CIDR.
+// When no CIDR is set, the interface gets the default CIDR of both families.
+func validateMasqueradeDNSNameserversFamilies(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if spec.DNSConfig == nil || len(spec.DNSConfig.Nameservers) == 0 {
+		return nil
+	}
+	if !vmispec.IsPodNetworkWithMasqueradeBindingInterface(spec.Networks, spec.Domain.Devices.Interfaces) {
+		return nil
+	}
+
+	nameserverFamilies := map[k8scorev1.IPFamily]struct{}{}
+	for _, nameserver := range spec.DNSConfig.Nameservers {
+		if ip := net.ParseIP(nameserver); ip != nil {
+			nameserverFamilies[ipFamilyOf(ip)] = struct{}{}
+		}
+	}
+	if len(nameserverFamilies) == 0 {
+		return nil
+	}
+
+	podNetwork := vmispec.LookupPodNetwork(spec.Networks).Pod
+	var cidrs []string
+	for _, cidr := range []string{podNetwork.VMNetworkCIDR, podNetwork.VMIPv6NetworkCIDR} {
+		if cidr != "" {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	if len(cidrs) == 0 {
+		cidrs = masqueradeCIDRs(podNetwork)
+	}
+	var families []string
+	for _, cidr := range cidrs {
+		ip, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		family := ipFamilyOf(ip)
+		if _, exists := nameserverFamilies[family]; exists {
+			return nil
+		}
+		families = append(families, string(family))
+	}
+	if len(families) == 0 {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type: metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("none of the DNS nameservers is reachable over %s, used by the masquerade interface",
+			strings.Join(families, " or ")),
+		Field: field.Child("dnsConfig", "nameservers").String(),
+	}}
+}
+
+func ipFamilyOf(ip net.IP) k8scorev1.IPFamily {
+	if ip.To4() != nil {
+		return k8scorev1.IPv4Protocol
+	}
+	return k8scorev1.IPv6Protocol
+}
+
+// WithClusterPodCIDRs sets the cluster pod network CIDRs, which the masquerade CIDRs may not overlap.
+func WithClusterPodCIDRs(cidrs ...string) option {
+	return func(v *Validator) {
+		v.clusterPodCIDRs = cidrs
+	}
+}
+
+func validateMasqueradeCIDRsNotOverlappingPodCIDRs(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, podCIDRs []string,
+) []metav1.StatusCause {
+	var podNetworks []*net.IPNet
+	for _, podCIDR := range podCIDRs {
+		if _, podNetwork, err := net.ParseCIDR(podCIDR); err == nil {
+			podNetworks = append(podNetworks, podNetwork)
+		}
+	}
+	if len(podNetworks) == 0 {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	networksByName := vmispec.IndexNetworkSpecByName(spec.Networks)
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		network, exists := networksByName[iface.Name]
+		if iface.Masquerade == nil || !exists || network.Pod == nil {
+			continue
+		}
+		for _, masqueradeCIDR := range masqueradeCIDRs(network.Pod) {
+			_, masqueradeNetwork, err := net.ParseCIDR(masqueradeCIDR)
+			if err != nil {
+				continue
+			}
+			for _, podNetwork := range podNetworks {
+				if masqueradeNetwork.Contains(podNetwork.IP) || podNetwork.Contains(masqueradeNetwork.IP) {
+					causes = append(causes, metav1.StatusCause{
+						Type: metav1.CauseTypeFieldValueInvalid,
+						Message: fmt.Sprintf("masquerade interface %q CIDR %s overlaps the cluster pod CIDR %s",
+							iface.Name, masqueradeCIDR, podNetwork),
+						Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+					})
+				}
+			}
+		}
+	}
+	return causes
+}
+
+// masqueradeInfrastructurePorts are the ports the masquerade binding skips when forwarding traffic to the VM,
+// as they are served by the pod infrastructure.
+var masqueradeInfrastructurePorts = map[int32]string{
+	masquerade.LibvirtDirectMigrationPort:    "libvirt direct migration",
+	masquerade.LibvirtBlockMigrationPort:     "libvirt block migration",
+	istio.EnvoyAdminPort:                     "istio sidecar",
+	istio.EnvoyOutboundPort:                  "istio sidecar",
+	istio.EnvoyDebugPort:                     "istio sidecar",
+	istio.EnvoyInboundPort:                   "istio sidecar",
+	istio.EnvoyTunnelPort:                    "istio sidecar",
+	istio.EnvoySecureNetworkPort:             "istio sidecar",
+	istio.EnvoyMergedPrometheusTelemetryPort: "istio sidecar",
+	istio.EnvoyHealthCheckPort:               "istio sidecar",
+	istio.EnvoyDNSPort:                       "istio sidecar",
+	istio.EnvoyPrometheusTelemetryPort:       "istio sidecar",
+}
+
+func validateMasqueradeInfrastructurePorts(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Masquerade == nil {
+			continue
+		}
+		for portIdx, forwardPort := range iface.Ports {
+			if user, reserved := masqueradeInfrastructurePorts[forwardPort.Port]; reserved {
+				causes = append(causes, metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("interface %q forwards port %d, which may be used by the %s and not reach the VM",
+						iface.Name, forwardPort.Port, user),
+					Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("port").String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+// WithReservedHostPorts sets the ports reserved on the nodes, such as the kubelet ones.
+func WithReservedHostPorts(ports ...int32) option {
+	return func(v *Validator) {
+		v.reservedHostPorts = ports
+	}
+}
+
+func validateMasqueradeReservedHostPorts(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, reservedHostPorts []int32,
+) []metav1.StatusCause {
+	if len(reservedHostPorts) == 0 {
+		return nil
+	}
+	reserved := make(map[int32]struct{}, len(reservedHostPorts))
+	for _, port := range reservedHostPorts {
+		reserved[port] = struct{}{}
+	}
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Masquerade == nil {
+			continue
+		}
+		for portIdx, forwardPort := range iface.Ports {
+			if _, isReserved := reserved[forwardPort.Port]; isReserved {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("interface %q forwards port %d, which is reserved on the nodes", iface.Name, forwardPort.Port),
+					Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("port").String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+func masqueradeCIDRs(podNetwork *v1.PodNetwork) []string {
+	cidrs := []string{vmispec.DefaultVMCIDR, vmispec.DefaultVMIpv6CIDR}
+	if podNetwork.VMNetworkCIDR != "" {
+		cidrs[0] = podNetwork.VMNetworkCIDR
+	}
+	if podNetwork.VMIPv6NetworkCIDR != "" {
+		cidrs[1] = podNetwork.VMIPv6NetworkCIDR
+	}
+	return cidrs
+}
+
+// isMasqueradeBridgeMAC reports whether the MAC address, in any notation net.ParseMAC accepts,
+// is the one reserved for the masquerade in-pod bridge.
+func isMasqueradeBridgeMAC(macAddress string) bool {
+	if link.IsReserved(macAddress) {
+		return true
+	}
+	mac, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return false
+	}
+	return link.IsReserved(mac.String())
+}
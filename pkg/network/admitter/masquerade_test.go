@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating masquerade binding", func() {
+	Context("on a dual-stack cluster", func() {
+		dualStack := admitter.WithClusterIPFamilies(k8scorev1.IPv4Protocol, k8scorev1.IPv6Protocol)
+
+		It("should warn when only an IPv4 CIDR is set", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{{
+				Name:          "default",
+				NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{VMNetworkCIDR: "10.10.10.0/24"}},
+			}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, dualStack)
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "masquerade interface \"default\" sets no IPv6 CIDR although the cluster supports it, the default CIDR is used",
+				Field:   "fake.networks[0].pod.vmIPv6NetworkCIDR",
+			}))
+		})
+
+		It("should warn when only an IPv6 CIDR is set", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{{
+				Name:          "default",
+				NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{VMIPv6NetworkCIDR: "fd10:10:10::/120"}},
+			}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, dualStack)
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "masquerade interface \"default\" sets no IPv4 CIDR although the cluster supports it, the default CIDR is used",
+				Field:   "fake.networks[0].pod.vmNetworkCIDR",
+			}))
+		})
+
+		DescribeTable("should not warn", func(podNetwork v1.PodNetwork) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{{Name: "default", NetworkSource: v1.NetworkSource{Pod: &podNetwork}}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, dualStack)
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		},
+			Entry("when both CIDRs are set", v1.PodNetwork{VMNetworkCIDR: "10.10.10.0/24", VMIPv6NetworkCIDR: "fd10:10:10::/120"}),
+			Entry("when no CIDR is set, both default CIDRs are used", v1.PodNetwork{}),
+		)
+	})
+
+	It("should not warn when only an IPv4 CIDR is set on a single-stack cluster", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Networks = []v1.Network{{
+			Name:          "default",
+			NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{VMNetworkCIDR: "10.10.10.0/24"}},
+		}}
+
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithClusterIPFamilies(k8scorev1.IPv4Protocol),
+		)
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	})
+
+	Context("with DNS config nameservers", func() {
+		It("should warn when the nameservers family does not match an IPv6 only masquerade CIDR", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{{
+				Name:          "default",
+				NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{VMIPv6NetworkCIDR: "fd10:0:2::/120"}},
+			}}
+			spec.DNSConfig = &k8scorev1.PodDNSConfig{Nameservers: []string{"8.8.8.8", "1.1.1.1"}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "none of the DNS nameservers is reachable over IPv6, used by the masquerade interface",
+				Field:   "fake.dnsConfig.nameservers",
+			}))
+		})
+
+		DescribeTable("should not warn", func(podNetwork *v1.PodNetwork, nameservers ...string) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{{Name: "default", NetworkSource: v1.NetworkSource{Pod: podNetwork}}}
+			spec.DNSConfig = &k8scorev1.PodDNSConfig{Nameservers: nameservers}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		},
+			Entry("on IPv6 nameservers with an IPv6 only masquerade CIDR",
+				&v1.PodNetwork{VMIPv6NetworkCIDR: "fd10:0:2::/120"}, "2001:4860:4860::8888",
+			),
+			Entry("on IPv4 nameservers with an IPv4 only masquerade CIDR", &v1.PodNetwork{VMNetworkCIDR: "10.11.12.0/24"}, "8.8.8.8"),
+			Entry("on IPv4 nameservers with the default masquerade CIDRs", &v1.PodNetwork{}, "8.8.8.8"),
+			Entry("on IPv6 nameservers with the default masquerade CIDRs", &v1.PodNetwork{}, "2001:4860:4860::8888"),
+		)
+	})
+
+	Context("with forwarded ports", func() {
+		DescribeTable("should warn on a port used by the pod infrastructure", func(port int32, user string) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].Ports = []v1.Port{{Name: "app", Port: port}}
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: fmt.Sprintf("interface \"default\" forwards port %d, which may be used by the %s and not reach the VM", port, user),
+				Field:   "fake.domain.devices.interfaces[0].ports[0].port",
+			}))
+		},
+			Entry("for the libvirt migration", int32(49152), "libvirt direct migration"),
+			Entry("for the istio sidecar", int32(15021), "istio sidecar"),
+		)
+
+		It("should not warn on a port not used by the pod infrastructure", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].Ports = []v1.Port{{Name: "app", Port: 8080}}
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+
+		It("should warn on a port reserved on the nodes", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].Ports = []v1.Port{{Name: "app", Port: 10250}}
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{},
+				admitter.WithReservedHostPorts(10250, 10256),
+			)
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "interface \"default\" forwards port 10250, which is reserved on the nodes",
+				Field:   "fake.domain.devices.interfaces[0].ports[0].port",
+			}))
+		})
+
+		It("should not warn on a port not reserved on the nodes", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].Ports = []v1.Port{{Name: "app", Port: 8080}}
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{},
+				admitter.WithReservedHostPorts(10250, 10256),
+			)
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+	})
+
+	Context("with the cluster pod CIDRs", func() {
+		withPodCIDRs := admitter.WithClusterPodCIDRs("10.244.0.0/16", "fd00:10:244::/56")
+
+		It("should reject a masquerade CIDR overlapping the pod CIDR", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{{
+				Name: "default",
+				NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{
+					VMNetworkCIDR:     "10.244.10.0/24",
+					VMIPv6NetworkCIDR: "fd10:10:10::/120",
+				}},
+			}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, withPodCIDRs)
+			Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "masquerade interface \"default\" CIDR 10.244.10.0/24 overlaps the cluster pod CIDR 10.244.0.0/16",
+				Field:   "fake.domain.devices.interfaces[0].name",
+			}))
+		})
+
+		It("should accept a masquerade CIDR disjoint from the pod CIDR", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{{
+				Name:          "default",
+				NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{VMNetworkCIDR: "10.10.10.0/24"}},
+			}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, withPodCIDRs)
+			Expect(validator.Validate()).To(BeEmpty())
+		})
+
+		It("should reject the default masquerade CIDR when the pod CIDR overlaps it", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithClusterPodCIDRs("10.0.0.0/8"),
+			)
+			Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "masquerade interface \"default\" CIDR 10.0.2.0/24 overlaps the cluster pod CIDR 10.0.0.0/8",
+				Field:   "fake.domain.devices.interfaces[0].name",
+			}))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+const (
+	// maxQueuesPerInterface matches the number of queues a tap device supports, the virtio queues are capped to it.
+	maxQueuesPerInterface = 256
+
+	// maxRecommendedNetworkQueues is the number of virtio queues, summed over all interfaces,
+	// above which the vhost threads and file descriptors required may exceed what a node is configured for.
+	maxRecommendedNetworkQueues = 1024
+)
+
+// validateMultiQueueCapacity warns when network multi-queue allocates more queues than a node reasonably handles,
+// each virtio interface gets a queue per vCPU.
+func validateMultiQueueCapacity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	multiQueue := spec.Domain.Devices.NetworkInterfaceMultiQueue
+	if multiQueue == nil || !*multiQueue || spec.Domain.CPU == nil {
+		return nil
+	}
+	queuesPerInterface := min(hwutil.GetNumberOfVCPUs(spec.Domain.CPU), maxQueuesPerInterface)
+
+	var virtioInterfaces int64
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		if iface.SRIOV == nil && (iface.Model == "" || iface.Model == v1.VirtIO) {
+			virtioInterfaces++
+		}
+	}
+
+	if totalQueues := virtioInterfaces * queuesPerInterface; totalQueues > maxRecommendedNetworkQueues {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%d virtio interfaces with %d queues each allocate %d queues, more than the %d recommended",
+				virtioInterfaces, queuesPerInterface, totalQueues, maxRecommendedNetworkQueues),
+			Field: field.Child("domain", "devices", "networkInterfaceMultiqueue").String(),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating network multi-queue", func() {
+	DescribeTable("should warn on the allocated queues",
+		func(interfaces int, cores uint32, multiQueue *bool, expectedCauses []metav1.StatusCause) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.CPU = &v1.CPU{Cores: cores}
+			spec.Domain.Devices.NetworkInterfaceMultiQueue = multiQueue
+			for i := 0; i < interfaces; i++ {
+				name := fmt.Sprintf("net%d", i)
+				spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+					Name:                   name,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				})
+				spec.Networks = append(spec.Networks, v1.Network{
+					Name:          name,
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: name}},
+				})
+			}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(expectedCauses))
+		},
+		Entry("when many interfaces and vCPUs allocate too many queues", 10, uint32(128), pointer.P(true), []metav1.StatusCause{{
+			Type:    "FieldValueInvalid",
+			Message: "10 virtio interfaces with 128 queues each allocate 1280 queues, more than the 1024 recommended",
+			Field:   "fake.domain.devices.networkInterfaceMultiqueue",
+		}}),
+		Entry("not when the queues fit the recommended capacity", 4, uint32(16), pointer.P(true), nil),
+		Entry("not when multi-queue is disabled", 10, uint32(128), nil, nil),
+	)
+})
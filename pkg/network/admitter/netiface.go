@@ -23,11 +23,13 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strings"
 
 	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -39,11 +41,19 @@ func validateNetworksAssignedToInterfaces(field *k8sfield.Path, spec *v1.Virtual
 	var causes []metav1.StatusCause
 	const nameOfTypeNotFoundMessagePattern = "%s '%s' not found."
 	interfaceSet := vmispec.IndexInterfaceSpecByName(spec.Domain.Devices.Interfaces)
+	unassignedInterfaceNames := interfacesWithoutNetworkNames(spec)
 	for i, network := range spec.Networks {
 		if _, exists := interfaceSet[network.Name]; !exists {
+			if caseOnlyMatch(network.Name, unassignedInterfaceNames) != "" {
+				continue
+			}
+			message := fmt.Sprintf(nameOfTypeNotFoundMessagePattern, field.Child("networks").Index(i).Child("name").String(), network.Name)
+			if suggestion := nearestName(network.Name, unassignedInterfaceNames); suggestion != "" {
+				message += fmt.Sprintf(" Did you mean '%s'?", suggestion)
+			}
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueRequired,
-				Message: fmt.Sprintf(nameOfTypeNotFoundMessagePattern, field.Child("networks").Index(i).Child("name").String(), network.Name),
+				Message: message,
 				Field:   field.Child("networks").Index(i).Child("name").String(),
 			})
 		}
@@ -51,12 +61,105 @@ func validateNetworksAssignedToInterfaces(field *k8sfield.Path, spec *v1.Virtual
 	return causes
 }
 
+// validateNetworkInterfaceNameCase reports a network and an interface whose names differ only in case.
+// These never link, which would otherwise be reported as a network and an interface missing their counterparts.
+func validateNetworkInterfaceNameCase(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	interfaceSet := vmispec.IndexInterfaceSpecByName(spec.Domain.Devices.Interfaces)
+	unassignedInterfaceNames := interfacesWithoutNetworkNames(spec)
+	for i, network := range spec.Networks {
+		if _, exists := interfaceSet[network.Name]; exists {
+			continue
+		}
+		if ifaceName := caseOnlyMatch(network.Name, unassignedInterfaceNames); ifaceName != "" {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("network %q and interface %q differ only in case, rename one of them so the names match exactly",
+					network.Name, ifaceName),
+				Field: field.Child("networks").Index(i).Child("name").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func interfacesWithoutNetworkNames(spec *v1.VirtualMachineInstanceSpec) []string {
+	networkSet := vmispec.IndexNetworkSpecByName(spec.Networks)
+	var names []string
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		if _, exists := networkSet[iface.Name]; !exists {
+			names = append(names, iface.Name)
+		}
+	}
+	return names
+}
+
+func networksWithoutInterfaceNames(spec *v1.VirtualMachineInstanceSpec) []string {
+	interfaceSet := vmispec.IndexInterfaceSpecByName(spec.Domain.Devices.Interfaces)
+	var names []string
+	for _, network := range spec.Networks {
+		if _, exists := interfaceSet[network.Name]; !exists {
+			names = append(names, network.Name)
+		}
+	}
+	return names
+}
+
+func caseOnlyMatch(name string, candidates []string) string {
+	for _, candidate := range candidates {
+		if strings.EqualFold(name, candidate) {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// maxSuggestionDistance is the highest edit distance for which a name is suggested as a typo fix.
+const maxSuggestionDistance = 2
+
+// nearestName returns the candidate closest to name, or an empty string when none is close enough.
+func nearestName(name string, candidates []string) string {
+	suggestion := ""
+	bestDistance := maxSuggestionDistance + 1
+	for _, candidate := range candidates {
+		if distance := levenshteinDistance(name, candidate); distance < bestDistance {
+			suggestion, bestDistance = candidate, distance
+		}
+	}
+	return suggestion
+}
+
+func levenshteinDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			substitutionCost := 1
+			if source[i-1] == target[j-1] {
+				substitutionCost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+substitutionCost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
+
 func validateInterfacesAssignedToNetworks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	const nameOfTypeNotFoundMessagePattern = "%s '%s' not found."
 	networkSet := vmispec.IndexNetworkSpecByName(spec.Networks)
+	unassignedNetworkNames := networksWithoutInterfaceNames(spec)
 	for idx, iface := range spec.Domain.Devices.Interfaces {
 		if _, exists := networkSet[iface.Name]; !exists {
+			if caseOnlyMatch(iface.Name, unassignedNetworkNames) != "" {
+				continue
+			}
 			causes = append(causes, metav1.StatusCause{
 				Type: metav1.CauseTypeFieldValueInvalid,
 				Message: fmt.Sprintf(
@@ -71,6 +174,20 @@ func validateInterfacesAssignedToNetworks(field *k8sfield.Path, spec *v1.Virtual
 	return causes
 }
 
+func validateNetworkNameFormat(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for i, network := range spec.Networks {
+		if network.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "Network name is required, it links the network to its interface",
+				Field:   field.Child("networks").Index(i).Child("name").String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateNetworkNameUnique(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	networkSet := map[string]struct{}{}
@@ -87,28 +204,104 @@ func validateNetworkNameUnique(field *k8sfield.Path, spec *v1.VirtualMachineInst
 	return causes
 }
 
+// validateNetworkNameWhitespace rejects network names with leading or trailing whitespace.
+// Such a name never matches the interface the user meant to link it to.
+func validateNetworkNameWhitespace(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for i, network := range spec.Networks {
+		if hasSurroundingWhitespace(network.Name) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Network name %q has leading or trailing whitespace, remove it so the network links to its interface", network.Name),
+				Field:   field.Child("networks").Index(i).Child("name").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func hasSurroundingWhitespace(name string) bool {
+	return strings.TrimSpace(name) != name
+}
+
+func validateInterfaceNotEmpty(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if equality.Semantic.DeepEqual(iface, v1.Interface{}) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "Network interface entry is empty, it requires at least a name",
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validateInterfaceNameUnique reports each duplicated interface name once, on its first duplicate occurrence,
+// listing all the indexes using it.
 func validateInterfaceNameUnique(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var names []string
+	indexesByName := map[string][]int{}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if _, exists := indexesByName[iface.Name]; !exists {
+			names = append(names, iface.Name)
+		}
+		indexesByName[iface.Name] = append(indexesByName[iface.Name], idx)
+	}
+
 	var causes []metav1.StatusCause
-	ifaceSet := map[string]struct{}{}
+	for _, name := range names {
+		indexes := indexesByName[name]
+		if len(indexes) < 2 {
+			continue
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueDuplicate,
+			Message: fmt.Sprintf("Only one interface can be connected to one specific network, interface name %q is used at indexes %v",
+				name, indexes),
+			Field: field.Child("domain", "devices", "interfaces").Index(indexes[1]).Child("name").String(),
+		})
+	}
+	return causes
+}
+
+// resourceSafeInterfaceName returns the form of the interface name used when deriving resource names from it,
+// which must be lowercase DNS-1123 labels.
+func resourceSafeInterfaceName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+}
+
+// validateInterfaceResourceSafeNameUnique rejects distinct interface names which map to the same resource name.
+// Identical names are left to validateInterfaceNameUnique.
+func validateInterfaceResourceSafeNameUnique(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	ifaceNameByResourceSafeName := map[string]string{}
 	for idx, iface := range spec.Domain.Devices.Interfaces {
-		if _, exists := ifaceSet[iface.Name]; exists {
+		resourceSafeName := resourceSafeInterfaceName(iface.Name)
+		otherIfaceName, exists := ifaceNameByResourceSafeName[resourceSafeName]
+		if !exists {
+			ifaceNameByResourceSafeName[resourceSafeName] = iface.Name
+			continue
+		}
+		if otherIfaceName != iface.Name {
 			causes = append(causes, metav1.StatusCause{
-				Type:    metav1.CauseTypeFieldValueDuplicate,
-				Message: "Only one interface can be connected to one specific network",
-				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+				Type: metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("interface names %q and %q both map to the resource name %q, rename one of them",
+					otherIfaceName, iface.Name, resourceSafeName),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
 			})
 		}
-		ifaceSet[iface.Name] = struct{}{}
 	}
 	return causes
 }
 
-func validateInterfacesFields(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+func validateInterfacesFields(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, arch string) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	networksByName := vmispec.IndexNetworkSpecByName(spec.Networks)
 	for idx, iface := range spec.Domain.Devices.Interfaces {
 		causes = append(causes, validateInterfaceNameFormat(field, idx, iface)...)
-		causes = append(causes, validateInterfaceModel(field, idx, iface)...)
+		causes = append(causes, validateInterfaceModel(field, idx, iface, arch)...)
 		causes = append(causes, validateMacAddress(field, idx, iface)...)
 		causes = append(causes, validatePciAddress(field, idx, iface)...)
 		causes = append(causes, validatePortConfiguration(field, idx, iface, networksByName[iface.Name])...)
@@ -118,11 +311,25 @@ func validateInterfacesFields(field *k8sfield.Path, spec *v1.VirtualMachineInsta
 }
 
 func validateInterfaceNameFormat(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if hasSurroundingWhitespace(iface.Name) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Network interface name %q has leading or trailing whitespace, remove it so the interface links to its network", iface.Name),
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+		}}
+	}
 	isValid := regexp.MustCompile(`^[A-Za-z0-9-_]+$`).MatchString
 	if !isValid(iface.Name) {
 		return []metav1.StatusCause{{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "Network interface name can only contain alphabetical characters, numbers, dashes (-) or underscores (_)",
+			Message: "Network interface name can only contain ASCII alphabetical characters, numbers, dashes (-) or underscores (_)",
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+		}}
+	}
+	if strings.HasPrefix(iface.Name, "-") || strings.HasSuffix(iface.Name, "-") || strings.Contains(iface.Name, "--") {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Network interface name cannot start or end with a dash (-) or contain consecutive dashes",
 			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
 		}}
 	}
@@ -139,19 +346,45 @@ var validInterfaceModels = map[string]struct{}{
 	v1.VirtIO:  {},
 }
 
-func validateInterfaceModel(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+var validInterfaceModelsByArch = map[string]map[string]struct{}{
+	"arm64": {"e1000e": {}, v1.VirtIO: {}},
+	"s390x": {v1.VirtIO: {}},
+}
+
+func validateInterfaceModel(field *k8sfield.Path, idx int, iface v1.Interface, arch string) []metav1.StatusCause {
 	if iface.Model != "" {
 		if _, exists := validInterfaceModels[iface.Model]; !exists {
+			message := fmt.Sprintf(
+				"interface %s uses model %s that is not supported.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+				iface.Model,
+			)
+			// Models are case-sensitive, point to the canonical spelling instead of a bare rejection.
+			if canonicalModel := strings.ToLower(iface.Model); canonicalModel != iface.Model {
+				if _, exists := validInterfaceModels[canonicalModel]; exists {
+					message = fmt.Sprintf("%s Did you mean %s?", message, canonicalModel)
+				}
+			}
 			return []metav1.StatusCause{{
-				Type: metav1.CauseTypeFieldValueNotSupported,
-				Message: fmt.Sprintf(
-					"interface %s uses model %s that is not supported.",
-					field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
-					iface.Model,
-				),
-				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("model").String(),
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: message,
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("model").String(),
 			}}
 		}
+		if archModels, isRestricted := validInterfaceModelsByArch[arch]; isRestricted {
+			if _, exists := archModels[iface.Model]; !exists {
+				return []metav1.StatusCause{{
+					Type: metav1.CauseTypeFieldValueNotSupported,
+					Message: fmt.Sprintf(
+						"interface %s uses model %s that is not supported on %s architecture.",
+						field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+						iface.Model,
+						arch,
+					),
+					Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("model").String(),
+				}}
+			}
+		}
 	}
 	return nil
 }
@@ -192,8 +425,9 @@ func validatePciAddress(field *k8sfield.Path, idx int, iface v1.Interface) []met
 
 func validatePortConfiguration(field *k8sfield.Path, idx int, iface v1.Interface, network v1.Network) []metav1.StatusCause {
 	var causes []metav1.StatusCause
-	if network.Pod != nil && iface.Ports != nil {
+	if network.Pod != nil && len(iface.Ports) > 0 {
 		causes = append(causes, validateForwardPortName(field, idx, iface.Ports)...)
+		causes = append(causes, validateForwardPortNamedPerProtocol(field, idx, iface.Ports)...)
 
 		for portIdx, forwardPort := range iface.Ports {
 			causes = append(causes, validateForwardPortNonZero(field, idx, forwardPort, portIdx)...)
@@ -204,14 +438,204 @@ func validatePortConfiguration(field *k8sfield.Path, idx int, iface v1.Interface
 	return causes
 }
 
+func validateForwardPortsUniqueAcrossInterfaces(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	type forwardedPort struct {
+		protocol string
+		port     int32
+	}
+	var causes []metav1.StatusCause
+	ifaceIdxByForwardedPort := map[forwardedPort]int{}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Masquerade == nil && iface.DeprecatedPasst == nil {
+			continue
+		}
+		for portIdx, port := range iface.Ports {
+			key := forwardedPort{protocol: forwardPortProtocol(port), port: port.Port}
+			if ownerIdx, exists := ifaceIdxByForwardedPort[key]; exists && ownerIdx != idx {
+				causes = append(causes, metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueDuplicate,
+					Message: fmt.Sprintf("Port %d/%s is already forwarded by interface %q",
+						key.port, key.protocol, spec.Domain.Devices.Interfaces[ownerIdx].Name),
+					Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).String(),
+				})
+				continue
+			}
+			ifaceIdxByForwardedPort[key] = idx
+		}
+	}
+	return causes
+}
+
+// DefaultMaxInterfacePorts is the number of ports an interface may forward unless overridden.
+const DefaultMaxInterfacePorts = 256
+
+// WithMaxInterfacePorts overrides the number of ports an interface may forward.
+func WithMaxInterfacePorts(maxPorts int) option {
+	return func(v *Validator) {
+		v.maxInterfacePorts = maxPorts
+	}
+}
+
+func validateInterfacePortsCount(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, maxPorts int) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if len(iface.Ports) > maxPorts {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q forwards %d ports, at most %d are allowed", iface.Name, len(iface.Ports), maxPorts),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validatePortsExposableByService(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if len(iface.Ports) > 0 && (iface.SRIOV != nil || iface.DeprecatedMacvtap != nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q ports cannot be exposed by a Service due to its binding", iface.Name),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// maxPrivilegedPort is the highest port number a Linux process needs privileges to bind.
+const maxPrivilegedPort = 1023
+
+// validateMasqueradePrivilegedPorts warns on masquerade interfaces forwarding privileged ports,
+// which the guest services may only listen on with extra capabilities.
+func validateMasqueradePrivilegedPorts(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Masquerade == nil {
+			continue
+		}
+		for portIdx, forwardPort := range iface.Ports {
+			if forwardPort.Port > 0 && forwardPort.Port <= maxPrivilegedPort {
+				causes = append(causes, metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("interface %q forwards the privileged port %d, which may require extra capabilities",
+						iface.Name, forwardPort.Port),
+					Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("port").String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+// predictableInterfaceNameRegex matches the names the guest kernel and udev assign to network devices,
+// such as enp0s1, eno1, ens3 or enx02aabbccddee.
+var predictableInterfaceNameRegex = regexp.MustCompile(`^(en|ib|sl|wl|ww)(o[0-9]+|s[0-9]+|p[0-9]+s[0-9]+|x[0-9a-f]{12})(f[0-9]+)?(d[0-9]+)?$`)
+
+func validateInterfaceNameNotPredictable(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if predictableInterfaceNameRegex.MatchString(iface.Name) {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface name %q mimics a predictable network device name and may collide with a guest assigned name",
+					iface.Name),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// maxRecommendedInterfaceNameLength matches the length of a Linux network device name (IFNAMSIZ without the terminator),
+// longer names cannot be reused as is in guest network configurations.
+const maxRecommendedInterfaceNameLength = 15
+
+func validateInterfaceNameLength(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if len(iface.Name) > maxRecommendedInterfaceNameLength {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface name %q is longer than %d characters, which some guest configurations do not support",
+					iface.Name, maxRecommendedInterfaceNameLength),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validateInterfaceModelNotEmulated warns on interfaces using an emulated NIC model, which is slower than virtio
+// and only needed for guests lacking the virtio drivers, such as Windows before the drivers are installed.
+func validateInterfaceModelNotEmulated(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Model == "" || iface.Model == v1.VirtIO {
+			continue
+		}
+		if _, exists := validInterfaceModels[iface.Model]; !exists {
+			continue
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interface %q uses the emulated %s model, which is slower than virtio "+
+				"and only needed for guests without the virtio drivers", iface.Name, iface.Model),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("model").String(),
+		})
+	}
+	return causes
+}
+
+// validateInterfaceNameNotNumeric warns on an all digits interface name, which tools may confuse with an interface index.
+func validateInterfaceNameNotNumeric(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Name != "" && strings.Trim(iface.Name, "0123456789") == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface name %q is purely numeric and may be mistaken for an interface index", iface.Name),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDefaultNetworkInterfaceACPIIndex(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	defaultNetwork := vmispec.LookUpDefaultNetwork(spec.Networks)
+	if defaultNetwork == nil {
+		return nil
+	}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Name == defaultNetwork.Name && iface.ACPIIndex != 0 {
+			return []metav1.StatusCause{{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf(
+					"interface %q is connected to the default network, setting its ACPI index may conflict with the firmware enumeration",
+					iface.Name,
+				),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("acpiIndex").String(),
+			}}
+		}
+	}
+	return nil
+}
+
 func validateForwardPortName(field *k8sfield.Path, idx int, ports []v1.Port) []metav1.StatusCause {
+	type namedPort struct {
+		name     string
+		protocol string
+	}
 	var causes []metav1.StatusCause
-	portForwardMap := map[string]struct{}{}
+	portForwardMap := map[namedPort]struct{}{}
 	for portIdx, forwardPort := range ports {
 		if forwardPort.Name == "" {
 			continue
 		}
-		if _, ok := portForwardMap[forwardPort.Name]; ok {
+		key := namedPort{name: forwardPort.Name, protocol: forwardPortProtocol(forwardPort)}
+		if _, ok := portForwardMap[key]; ok {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueDuplicate,
 				Message: fmt.Sprintf("Duplicate name of the port: %s", forwardPort.Name),
@@ -225,14 +649,73 @@ func validateForwardPortName(field *k8sfield.Path, idx int, ports []v1.Port) []m
 				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("name").String(),
 			})
 		}
-		portForwardMap[forwardPort.Name] = struct{}{}
+		portForwardMap[key] = struct{}{}
+	}
+	return causes
+}
+
+// validateForwardPortNameAcrossProtocols warns on a port name reused by ports of different protocols.
+// It is legit when a service listens on both protocols (e.g. DNS), but is otherwise likely a copy-paste mistake.
+func validateForwardPortNameAcrossProtocols(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		protocolByPortName := map[string]string{}
+		for portIdx, forwardPort := range iface.Ports {
+			if forwardPort.Name == "" {
+				continue
+			}
+			protocol := forwardPortProtocol(forwardPort)
+			firstProtocol, exists := protocolByPortName[forwardPort.Name]
+			if !exists {
+				protocolByPortName[forwardPort.Name] = protocol
+				continue
+			}
+			if firstProtocol != protocol {
+				causes = append(causes, metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf(
+						"interface %q port name %q is used by both %s and %s ports, only the %s port is named on the virt-launcher pod",
+						iface.Name, forwardPort.Name, firstProtocol, protocol, firstProtocol),
+					Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("name").String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+// validateForwardPortNamedPerProtocol requires ports to be named when an interface has several ports
+// of the same protocol, so they can be told apart once exposed by a Service.
+func validateForwardPortNamedPerProtocol(field *k8sfield.Path, idx int, ports []v1.Port) []metav1.StatusCause {
+	portsCountByProtocol := map[string]int{}
+	for _, forwardPort := range ports {
+		portsCountByProtocol[forwardPortProtocol(forwardPort)]++
+	}
+	var causes []metav1.StatusCause
+	for portIdx, forwardPort := range ports {
+		protocol := forwardPortProtocol(forwardPort)
+		if forwardPort.Name == "" && portsCountByProtocol[protocol] > 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("Port name is required when multiple ports use the %s protocol", protocol),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("name").String(),
+			})
+		}
 	}
 	return causes
 }
 
+// forwardPortProtocol returns the canonical, uppercase, protocol of the port, defaulting to TCP.
+func forwardPortProtocol(forwardPort v1.Port) string {
+	if forwardPort.Protocol == "" {
+		return "TCP"
+	}
+	return strings.ToUpper(forwardPort.Protocol)
+}
+
 func validateForwardPortProtocol(field *k8sfield.Path, idx int, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
 	if forwardPort.Protocol != "" {
-		if forwardPort.Protocol != "TCP" && forwardPort.Protocol != "UDP" {
+		if protocol := forwardPortProtocol(forwardPort); protocol != "TCP" && protocol != "UDP" {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: "Unknown protocol, only TCP or UDP allowed",
@@ -52,6 +52,71 @@ var _ = Describe("Validating VMI network spec", func() {
 		}))
 	})
 
+	DescribeTable("should suggest the nearest unassigned interface for a network with a missing interface",
+		func(networkName, expectedMessage string) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{{
+				Name:          networkName,
+				NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}},
+			}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+				Type:    "FieldValueRequired",
+				Message: expectedMessage,
+				Field:   "fake.networks[0].name",
+			}))
+		},
+		Entry("when the name is a typo", "defualt", "fake.networks[0].name 'defualt' not found. Did you mean 'default'?"),
+		Entry("not when the name is wholly different", "frontend", "fake.networks[0].name 'frontend' not found."),
+	)
+
+	It("should not link a network and an interface whose names differ by a trailing index", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "net1",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name:          "net",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(
+			metav1.StatusCause{
+				Type:    "FieldValueRequired",
+				Message: "fake.networks[0].name 'net' not found. Did you mean 'net1'?",
+				Field:   "fake.networks[0].name",
+			},
+			metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "fake.domain.devices.interfaces[0].name 'net1' not found.",
+				Field:   "fake.domain.devices.interfaces[0].name",
+			},
+		))
+	})
+
+	It("should reject a network and an interface whose names differ only in case with a single cause", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "net",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name:          "Net",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net-attach-def"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "network \"Net\" and interface \"net\" differ only in case, rename one of them so the names match exactly",
+			Field:   "fake.networks[0].name",
+		}))
+	})
+
 	It("should reject interface with missing network", func() {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
@@ -111,11 +176,80 @@ var _ = Describe("Validating VMI network spec", func() {
 
 		Expect(causes).To(ContainElements(metav1.StatusCause{
 			Type:    "FieldValueDuplicate",
-			Message: "Only one interface can be connected to one specific network",
+			Message: "Only one interface can be connected to one specific network, interface name \"default\" is used at indexes [0 1]",
+			Field:   "fake.domain.devices.interfaces[1].name",
+		}))
+	})
+
+	It("should report an interface name used three times with a single cause", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		for i := 0; i < 3; i++ {
+			spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+				Name:                   "net",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			})
+		}
+		spec.Networks = []v1.Network{{Name: "net", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueDuplicate",
+			Message: "Only one interface can be connected to one specific network, interface name \"net\" is used at indexes [0 1 2]",
 			Field:   "fake.domain.devices.interfaces[1].name",
 		}))
 	})
 
+	DescribeTable("should reject interface names mapping to the same resource name", func(firstName, secondName, resourceName string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		for _, name := range []string{firstName, secondName} {
+			spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+				Name:                   name,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			})
+			spec.Networks = append(spec.Networks, v1.Network{
+				Name:          name,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}},
+			})
+		}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type: "FieldValueDuplicate",
+			Message: fmt.Sprintf("interface names %q and %q both map to the resource name %q, rename one of them",
+				firstName, secondName, resourceName),
+			Field: "fake.domain.devices.interfaces[1].name",
+		}))
+	},
+		Entry("when differing by an underscore and a dash", "red_net", "red-net", "red-net"),
+		Entry("when differing by case", "Red", "red", "red"),
+	)
+
+	It("should reject a zero-value interface entry with a single cause", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface(), {}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueRequired",
+			Message: "Network interface entry is empty, it requires at least a name",
+			Field:   "fake.domain.devices.interfaces[1]",
+		}))
+	})
+
+	It("should reject an empty network name before checking its interface", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Networks = []v1.Network{{NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueRequired",
+			Message: "Network name is required, it links the network to its interface",
+			Field:   "fake.networks[0].name",
+		}))
+	})
+
 	It("should reject interface named with unsupported characters", func() {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		spec.Domain.Devices.Interfaces = []v1.Interface{{
@@ -127,9 +261,102 @@ var _ = Describe("Validating VMI network spec", func() {
 		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
 		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
 			Type:    "FieldValueInvalid",
-			Message: "Network interface name can only contain alphabetical characters, numbers, dashes (-) or underscores (_)",
+			Message: "Network interface name can only contain ASCII alphabetical characters, numbers, dashes (-) or underscores (_)",
+			Field:   "fake.domain.devices.interfaces[0].name",
+		}))
+	})
+
+	It("should reject interface and network names with trailing spaces", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default ",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+		}}
+		spec.Networks = []v1.Network{{Name: "default ", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(
+			metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "Network name \"default \" has leading or trailing whitespace, remove it so the network links to its interface",
+				Field:   "fake.networks[0].name",
+			},
+			metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "Network interface name \"default \" has leading or trailing whitespace, remove it so the interface links to its network",
+				Field:   "fake.domain.devices.interfaces[0].name",
+			},
+		))
+	})
+
+	It("should reject a network name with a trailing space not linked to its interface", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+		}}
+		spec.Networks = []v1.Network{{Name: "default ", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "Network name \"default \" has leading or trailing whitespace, remove it so the network links to its interface",
+			Field:   "fake.networks[0].name",
+		}))
+	})
+
+	DescribeTable("should reject interface named with non-ASCII lookalike characters", func(name string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+		}}
+		spec.Networks = []v1.Network{{Name: name, NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "Network interface name can only contain ASCII alphabetical characters, numbers, dashes (-) or underscores (_)",
+			Field:   "fake.domain.devices.interfaces[0].name",
+		}))
+	},
+		Entry("Cyrillic 'е' instead of Latin 'e'", "d\u0435fault"),
+		Entry("Cyrillic 'а' instead of Latin 'a'", "def\u0430ult"),
+		Entry("Greek 'ο' instead of Latin 'o'", "net\u03bf"),
+		Entry("fullwidth digit", "net\uff11"),
+		Entry("non-breaking hyphen", "red\u2011net"),
+	)
+
+	DescribeTable("should reject interface named with misplaced dashes", func(name string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+		}}
+		spec.Networks = []v1.Network{{Name: name, NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "Network interface name cannot start or end with a dash (-) or contain consecutive dashes",
 			Field:   "fake.domain.devices.interfaces[0].name",
 		}))
+	},
+		Entry("leading dash", "-a"),
+		Entry("trailing dash", "a-"),
+		Entry("consecutive dashes", "a--b"),
+	)
+
+	It("should accept interface named with an inner dash", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "a-b",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+		}}
+		spec.Networks = []v1.Network{{Name: "a-b", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
 	})
 
 	It("should reject invalid interface model", func() {
@@ -146,6 +373,25 @@ var _ = Describe("Validating VMI network spec", func() {
 		}))
 	})
 
+	DescribeTable("should reject an interface model not in its canonical case", func(model, canonicalModel string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Model = model
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type: "FieldValueNotSupported",
+			Message: fmt.Sprintf(
+				"interface fake.domain.devices.interfaces[0].name uses model %s that is not supported. Did you mean %s?", model, canonicalModel,
+			),
+			Field: "fake.domain.devices.interfaces[0].model",
+		}))
+	},
+		Entry("Virtio", "Virtio", "virtio"),
+		Entry("E1000", "E1000", "e1000"),
+	)
+
 	It("should accept valid interface model", func() {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
@@ -156,6 +402,66 @@ var _ = Describe("Validating VMI network spec", func() {
 		Expect(validator.Validate()).To(BeEmpty())
 	})
 
+	It("should accept an emulated interface model with a warning", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Model = "e1000e"
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+		Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+			Type: "FieldValueInvalid",
+			Message: "interface \"default\" uses the emulated e1000e model, which is slower than virtio " +
+				"and only needed for guests without the virtio drivers",
+			Field: "fake.domain.devices.interfaces[0].model",
+		}))
+	})
+
+	It("should not warn on the virtio interface model", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Model = v1.VirtIO
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	})
+
+	DescribeTable("should reject interface model not supported on the architecture", func(arch, model string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Model = model
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithArchitecture(arch))
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type: "FieldValueNotSupported",
+			Message: fmt.Sprintf(
+				"interface fake.domain.devices.interfaces[0].name uses model %s that is not supported on %s architecture.", model, arch,
+			),
+			Field: "fake.domain.devices.interfaces[0].model",
+		}))
+	},
+		Entry("e1000 on s390x", "s390x", "e1000"),
+		Entry("rtl8139 on arm64", "arm64", "rtl8139"),
+	)
+
+	DescribeTable("should accept interface model supported on the architecture", func(arch, model string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Model = model
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithArchitecture(arch))
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("virtio on s390x", "s390x", v1.VirtIO),
+		Entry("virtio on arm64", "arm64", v1.VirtIO),
+		Entry("e1000e on arm64", "arm64", "e1000e"),
+		Entry("e1000 on amd64", "amd64", "e1000"),
+	)
+
 	DescribeTable("should reject invalid MAC addresses", func(macAddress, expectedMessage string) {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
@@ -254,6 +560,31 @@ var _ = Describe("Validating VMI network spec", func() {
 					Field:   "fake.domain.devices.interfaces[0].ports[0]",
 				}},
 			),
+			Entry(
+				"two unnamed ports with protocols differing only in case",
+				[]v1.Port{{Protocol: "tcp", Port: 80}, {Protocol: "TCP", Port: 443}},
+				[]metav1.StatusCause{
+					{
+						Type:    "FieldValueRequired",
+						Message: "Port name is required when multiple ports use the TCP protocol",
+						Field:   "fake.domain.devices.interfaces[0].ports[0].name",
+					},
+					{
+						Type:    "FieldValueRequired",
+						Message: "Port name is required when multiple ports use the TCP protocol",
+						Field:   "fake.domain.devices.interfaces[0].ports[1].name",
+					},
+				},
+			),
+			Entry(
+				"bad protocol type in lowercase",
+				[]v1.Port{{Protocol: "sctp", Port: 80}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "Unknown protocol, only TCP or UDP allowed",
+					Field:   "fake.domain.devices.interfaces[0].ports[0].protocol",
+				}},
+			),
 			Entry(
 				"bad protocol type",
 				[]v1.Port{{Protocol: "bad", Port: 80}},
@@ -273,14 +604,30 @@ var _ = Describe("Validating VMI network spec", func() {
 				}},
 			),
 			Entry(
-				"two ports that have the same name",
-				[]v1.Port{{Name: "testport", Port: 80}, {Name: "testport", Protocol: "UDP", Port: 80}},
+				"two ports of the same protocol that have the same name",
+				[]v1.Port{{Name: "testport", Port: 80}, {Name: "testport", Protocol: "tcp", Port: 81}},
 				[]metav1.StatusCause{{
 					Type:    "FieldValueDuplicate",
 					Message: "Duplicate name of the port: testport",
 					Field:   "fake.domain.devices.interfaces[0].ports[1].name",
 				}},
 			),
+			Entry(
+				"two unnamed TCP ports",
+				[]v1.Port{{Port: 80}, {Protocol: "TCP", Port: 443}},
+				[]metav1.StatusCause{
+					{
+						Type:    "FieldValueRequired",
+						Message: "Port name is required when multiple ports use the TCP protocol",
+						Field:   "fake.domain.devices.interfaces[0].ports[0].name",
+					},
+					{
+						Type:    "FieldValueRequired",
+						Message: "Port name is required when multiple ports use the TCP protocol",
+						Field:   "fake.domain.devices.interfaces[0].ports[1].name",
+					},
+				},
+			),
 			Entry(
 				"bad port name",
 				[]v1.Port{{Name: "Test", Port: 80}},
@@ -308,9 +655,186 @@ var _ = Describe("Validating VMI network spec", func() {
 			Entry("multiple ports, same number, with protocol and without", []v1.Port{{Port: 80}, {Protocol: "UDP", Port: 80}}),
 			Entry(
 				"multiple ports, same number, different protocols",
-				[]v1.Port{{Port: 80}, {Protocol: "UDP", Port: 80}, {Protocol: "TCP", Port: 80}},
+				[]v1.Port{{Name: "http", Port: 80}, {Protocol: "UDP", Port: 80}, {Name: "http-tcp", Protocol: "TCP", Port: 80}},
+			),
+			Entry("two named TCP ports", []v1.Port{{Name: "http", Port: 80}, {Name: "https", Port: 443}}),
+			Entry("mixed-case protocols",
+				[]v1.Port{{Name: "http", Protocol: "tcp", Port: 80}, {Name: "dns", Protocol: "Udp", Port: 53}},
+			),
+			Entry("the same name for ports of different protocols",
+				[]v1.Port{{Name: "dns", Protocol: "TCP", Port: 53}, {Name: "dns", Protocol: "UDP", Port: 53}},
 			),
 		)
+
+		It("should warn when a port name is reused across protocols", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports:                  []v1.Port{{Name: "dns", Port: 5353}, {Name: "dns", Protocol: "udp", Port: 5353}},
+			}}
+			spec.Networks = []v1.Network{{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type: "FieldValueInvalid",
+				Message: "interface \"default\" port name \"dns\" is used by both TCP and UDP ports, " +
+					"only the TCP port is named on the virt-launcher pod",
+				Field: "fake.domain.devices.interfaces[0].ports[1].name",
+			}))
+		})
+
+		It("should not warn when port names are distinct", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports:                  []v1.Port{{Name: "dns-tcp", Port: 5353}, {Name: "dns-udp", Protocol: "UDP", Port: 5353}},
+			}}
+			spec.Networks = []v1.Network{{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+	})
+
+	It("should reject a port forwarded by more than one interface", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{
+			{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports:                  []v1.Port{{Port: 80}},
+			},
+			{
+				Name:                   "secondary",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports:                  []v1.Port{{Protocol: "UDP", Port: 80}, {Protocol: "TCP", Port: 80}},
+			},
+		}
+		spec.Networks = []v1.Network{
+			{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+			{Name: "secondary", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+		}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		causes := validator.Validate()
+		Expect(causes).To(ContainElement(metav1.StatusCause{
+			Type:    "FieldValueDuplicate",
+			Message: "Port 80/TCP is already forwarded by interface \"default\"",
+			Field:   "fake.domain.devices.interfaces[1].ports[1]",
+		}))
+		Expect(causes).NotTo(ContainElement(HaveField("Field", "fake.domain.devices.interfaces[1].ports[0]")))
+	})
+
+	It("should reject a port forwarded by more than one interface with protocols differing only in case", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{
+			{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports:                  []v1.Port{{Protocol: "TCP", Port: 80}},
+			},
+			{
+				Name:                   "secondary",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports:                  []v1.Port{{Protocol: "tcp", Port: 80}},
+			},
+		}
+		spec.Networks = []v1.Network{
+			{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+			{Name: "secondary", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+		}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+			Type:    "FieldValueDuplicate",
+			Message: "Port 80/TCP is already forwarded by interface \"default\"",
+			Field:   "fake.domain.devices.interfaces[1].ports[0]",
+		}))
+	})
+
+	It("should warn when ports are specified on an SR-IOV interface", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "sriov",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			Ports:                  []v1.Port{{Port: 80}},
+		}}
+		spec.Networks = []v1.Network{{Name: "sriov", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface \"sriov\" ports cannot be exposed by a Service due to its binding",
+			Field:   "fake.domain.devices.interfaces[0].ports",
+		}))
+	})
+
+	It("should not warn when ports are specified on a masquerade interface", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			Ports:                  []v1.Port{{Port: 8080}},
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	})
+
+	DescribeTable("forwarded port on a masquerade interface", func(port int32, expectedWarnings []metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			Ports:                  []v1.Port{{Port: port}},
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(ConsistOf(expectedWarnings))
+	},
+		Entry("should warn on a privileged port", int32(80), []metav1.StatusCause{{
+			Type:    "FieldValueInvalid",
+			Message: "interface \"default\" forwards the privileged port 80, which may require extra capabilities",
+			Field:   "fake.domain.devices.interfaces[0].ports[0].port",
+		}}),
+		Entry("should not warn on an unprivileged port", int32(8080), nil),
+	)
+
+	It("should warn when the default network interface sets an ACPI index", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].ACPIIndex = 1
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface \"default\" is connected to the default network, setting its ACPI index may conflict with the firmware enumeration",
+			Field:   "fake.domain.devices.interfaces[0].acpiIndex",
+		}))
+	})
+
+	It("should not warn when a secondary network interface sets an ACPI index", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{
+			*v1.DefaultMasqueradeNetworkInterface(),
+			{
+				Name:                   "secondary",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				ACPIIndex:              2,
+			},
+		}
+		spec.Networks = []v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: "secondary", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}}},
+		}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
 	})
 
 	When("the interface DHCP options is specified", func() {
@@ -391,4 +915,133 @@ var _ = Describe("Validating VMI network spec", func() {
 			),
 		)
 	})
+
+	Context("interface ports count", func() {
+		const maxPorts = 2
+
+		DescribeTable("should validate the ports count against the limit", func(count int, expectedCauses []metav1.StatusCause) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			for i := 0; i < count; i++ {
+				spec.Domain.Devices.Interfaces[0].Ports = append(spec.Domain.Devices.Interfaces[0].Ports,
+					v1.Port{Name: fmt.Sprintf("port%d", i), Port: int32(1000 + i)},
+				)
+			}
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithMaxInterfacePorts(maxPorts),
+			)
+			Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+		},
+			Entry("accepting an interface forwarding ports up to the limit", maxPorts, nil),
+			Entry("rejecting an interface forwarding more ports than the limit", maxPorts+1, []metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface \"default\" forwards 3 ports, at most 2 are allowed",
+				Field:   "fake.domain.devices.interfaces[0].ports",
+			}}),
+		)
+
+		It("should apply the default limit when none is set", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			for i := 0; i <= admitter.DefaultMaxInterfacePorts; i++ {
+				spec.Domain.Devices.Interfaces[0].Ports = append(spec.Domain.Devices.Interfaces[0].Ports,
+					v1.Port{Name: fmt.Sprintf("port%d", i), Port: int32(1000 + i)},
+				)
+			}
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+				Type: "FieldValueInvalid",
+				Message: fmt.Sprintf("interface \"default\" forwards %d ports, at most %d are allowed",
+					admitter.DefaultMaxInterfacePorts+1, admitter.DefaultMaxInterfacePorts),
+				Field: "fake.domain.devices.interfaces[0].ports",
+			}))
+		})
+	})
+
+	DescribeTable("should warn on an interface name mimicking a predictable name", func(ifaceName string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   ifaceName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name:          ifaceName,
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+			Type: "FieldValueInvalid",
+			Message: fmt.Sprintf("interface name %q mimics a predictable network device name and may collide with a guest assigned name",
+				ifaceName),
+			Field: "fake.domain.devices.interfaces[0].name",
+		}))
+	},
+		Entry("PCI path based", "enp0s1"),
+		Entry("onboard index based", "eno1"),
+		Entry("hotplug slot based", "ens3"),
+	)
+
+	It("should not warn on an interface name unlike a predictable name", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "frontend",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name:          "frontend",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	})
+
+	DescribeTable("interface name length", func(ifaceName string, expectedWarnings []metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   ifaceName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name:          ifaceName,
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(ConsistOf(expectedWarnings))
+	},
+		Entry("should warn on a 16 characters name", "backend-network1", []metav1.StatusCause{{
+			Type:    "FieldValueInvalid",
+			Message: "interface name \"backend-network1\" is longer than 15 characters, which some guest configurations do not support",
+			Field:   "fake.domain.devices.interfaces[0].name",
+		}}),
+		Entry("should not warn on a 10 characters name", "backend-10", nil),
+	)
+
+	DescribeTable("numeric interface name", func(ifaceName string, expectedWarnings []metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   ifaceName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name:          ifaceName,
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(ConsistOf(expectedWarnings))
+	},
+		Entry("should warn on an all digits name", "123", []metav1.StatusCause{{
+			Type:    "FieldValueInvalid",
+			Message: "interface name \"123\" is purely numeric and may be mistaken for an interface index",
+			Field:   "fake.domain.devices.interfaces[0].name",
+		}}),
+		Entry("should not warn on a name containing digits", "net1", nil),
+	)
 })
@@ -21,8 +21,10 @@ package admitter
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -97,3 +99,127 @@ func validateMultusNetworkSource(field *k8sfield.Path, spec *v1.VirtualMachineIn
 	}
 	return nil
 }
+
+// validateMultusNetworkName rejects a network attachment reference whose namespace, in the <namespace>/<name> form,
+// is not a legal namespace name, or whose name exceeds the object name length limit.
+func validateMultusNetworkName(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, net := range spec.Networks {
+		if net.Multus == nil {
+			continue
+		}
+		name := net.Multus.NetworkName
+		if namespace, nsName, hasNamespace := strings.Cut(net.Multus.NetworkName, "/"); hasNamespace {
+			name = nsName
+			if errs := k8svalidation.IsDNS1123Label(namespace); len(errs) > 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("network %q references the network attachment namespace %q which is not a valid namespace name: %s",
+						net.Name, namespace, strings.Join(errs, ", ")),
+					Field: field.Child("networks").Index(idx).Child("multus", "networkName").String(),
+				})
+			}
+		}
+		if len(name) > k8svalidation.DNS1123SubdomainMaxLength {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("network %q references a network attachment name of %d characters, more than the %d allowed",
+					net.Name, len(name), k8svalidation.DNS1123SubdomainMaxLength),
+				Field: field.Child("networks").Index(idx).Child("multus", "networkName").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// WithMaxSecondaryNetworks sets a soft limit on the number of secondary networks, zero disables it.
+func WithMaxSecondaryNetworks(maxSecondaryNetworks int) option {
+	return func(v *Validator) {
+		v.maxSecondaryNetworks = maxSecondaryNetworks
+	}
+}
+
+func validateSecondaryNetworksCount(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, maxSecondaryNetworks int,
+) []metav1.StatusCause {
+	if maxSecondaryNetworks <= 0 {
+		return nil
+	}
+	secondaryNetworks := vmispec.FilterNetworksSpec(spec.Networks, func(n v1.Network) bool {
+		return n.Multus != nil && !n.Multus.Default
+	})
+	if len(secondaryNetworks) > maxSecondaryNetworks {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%d secondary networks are attached, more than %d may slow down the pod startup",
+				len(secondaryNetworks), maxSecondaryNetworks),
+			Field: field.Child("networks").String(),
+		}}
+	}
+	return nil
+}
+
+// WithMaxNetworkAttachmentDefinitions sets a limit on the number of distinct network attachment definitions
+// a VMI may reference, zero disables it.
+func WithMaxNetworkAttachmentDefinitions(maxNetworkAttachmentDefinitions int) option {
+	return func(v *Validator) {
+		v.maxNetworkAttachmentDefinitions = maxNetworkAttachmentDefinitions
+	}
+}
+
+func validateNetworkAttachmentDefinitionsCount(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, maxNetworkAttachmentDefinitions int,
+) []metav1.StatusCause {
+	if maxNetworkAttachmentDefinitions <= 0 {
+		return nil
+	}
+	networkAttachmentDefinitions := map[string]struct{}{}
+	for _, network := range spec.Networks {
+		if network.Multus != nil {
+			networkAttachmentDefinitions[network.Multus.NetworkName] = struct{}{}
+		}
+	}
+	if len(networkAttachmentDefinitions) > maxNetworkAttachmentDefinitions {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%d distinct network attachment definitions are referenced, at most %d are allowed",
+				len(networkAttachmentDefinitions), maxNetworkAttachmentDefinitions),
+			Field: field.Child("networks").String(),
+		}}
+	}
+	return nil
+}
+
+// validateDefaultRouteWithoutPodInterface warns when the pod interface is not auto attached and no network
+// replaces it, as the VM is then left without a default route nor access to the cluster DNS.
+// A spec without any interface nor network explicitly opts out of networking and is not reported.
+func validateDefaultRouteWithoutPodInterface(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	autoattach := spec.Domain.Devices.AutoattachPodInterface
+	if autoattach == nil || *autoattach || vmispec.LookUpDefaultNetwork(spec.Networks) != nil {
+		return nil
+	}
+	if len(spec.Domain.Devices.Interfaces) == 0 && len(spec.Networks) == 0 {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type: metav1.CauseTypeFieldValueInvalid,
+		Message: "pod interface auto attachment is disabled and no pod or default multus network is set, " +
+			"the VM has no default route",
+		Field: field.Child("domain", "devices", "autoattachPodInterface").String(),
+	}}
+}
+
+// validateInterfacesWithoutPodInterfaceAutoattach warns when the pod interface auto attachment is disabled
+// while interfaces are declared: the setting only applies to a spec without any, the declared interfaces still attach.
+func validateInterfacesWithoutPodInterfaceAutoattach(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	autoattach := spec.Domain.Devices.AutoattachPodInterface
+	if autoattach == nil || *autoattach || len(spec.Domain.Devices.Interfaces) == 0 {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type: metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("pod interface auto attachment is disabled but %d interfaces are declared, they are still attached",
+			len(spec.Domain.Devices.Interfaces)),
+		Field: field.Child("domain", "devices", "autoattachPodInterface").String(),
+	}}
+}
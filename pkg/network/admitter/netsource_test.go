@@ -20,14 +20,19 @@
 package admitter_test
 
 import (
+	"fmt"
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validate network source", func() {
@@ -70,6 +75,29 @@ var _ = Describe("Validate network source", func() {
 		Expect(causes[0].Message).To(Equal("should have only one network type"))
 	})
 
+	It("should reject a multus network setting pod network fields as carrying multiple types", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "red",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name: "red",
+			NetworkSource: v1.NetworkSource{
+				Multus: &v1.MultusNetwork{NetworkName: "red-net"},
+				Pod:    &v1.PodNetwork{VMNetworkCIDR: "10.10.10.0/24", VMIPv6NetworkCIDR: "fd10:10:10::/120"},
+			},
+		}}
+
+		clusterConfig := stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueRequired",
+			Message: "should have only one network type",
+			Field:   "fake.networks[0]",
+		}))
+	})
+
 	It("when network source is not configured", func() {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		net1 := v1.Network{
@@ -99,6 +127,58 @@ var _ = Describe("Validate network source", func() {
 		Expect(causes[0].Message).To(Equal("CNI delegating plugin must have a networkName"))
 	})
 
+	DescribeTable("should accept a multus network name", func(networkName string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+		spec.Networks = []v1.Network{{
+			Name:          "default",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: networkName}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("without a namespace", "red-net"),
+		Entry("with a valid namespace", "team-a/red-net"),
+		Entry("with a name at the length limit", "team-a/"+strings.Repeat("a", 253)),
+	)
+
+	It("should reject a multus network name with an overlong name", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+		spec.Networks = []v1.Network{{
+			Name:          "default",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: strings.Repeat("a", 254)}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "network \"default\" references a network attachment name of 254 characters, more than the 253 allowed",
+			Field:   "fake.networks[0].multus.networkName",
+		}))
+	})
+
+	It("should reject a multus network name with an overlong namespace", func() {
+		namespace := strings.Repeat("a", 64)
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+		spec.Networks = []v1.Network{{
+			Name:          "default",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: namespace + "/red-net"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type: "FieldValueInvalid",
+			Message: fmt.Sprintf(
+				"network \"default\" references the network attachment namespace %q which is not a valid namespace name: "+
+					"must be no more than 63 characters", namespace,
+			),
+			Field: "fake.networks[0].multus.networkName",
+		}))
+	})
+
 	It("should reject multiple multus networks with a multus default", func() {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		spec.Domain.Devices.Interfaces = []v1.Interface{
@@ -162,4 +242,180 @@ var _ = Describe("Validate network source", func() {
 		Expect(causes[0].Field).To(Equal("fake.networks"))
 		Expect(causes[0].Message).To(Equal("Pod network cannot be defined when Multus default network is defined"))
 	})
+
+	Context("secondary networks count", func() {
+		const maxSecondaryNetworks = 2
+
+		DescribeTable("should validate the secondary networks count against the limit", func(count int, expectedCauses []metav1.StatusCause) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			for i := 0; i < count; i++ {
+				name := fmt.Sprintf("net%d", i)
+				spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+					Name:                   name,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				})
+				spec.Networks = append(spec.Networks, v1.Network{
+					Name:          name,
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: name}},
+				})
+			}
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithMaxSecondaryNetworks(maxSecondaryNetworks),
+			)
+			Expect(validator.ValidateWarnings()).To(ConsistOf(expectedCauses))
+		},
+			Entry("not warning when the count is at the limit", maxSecondaryNetworks, nil),
+			Entry("warning when the count is above the limit", maxSecondaryNetworks+1, []metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "3 secondary networks are attached, more than 2 may slow down the pod startup",
+				Field:   "fake.networks",
+			}}),
+		)
+
+		It("should not warn when no limit is set", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			for i := 0; i < 10; i++ {
+				name := fmt.Sprintf("net%d", i)
+				spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+					Name:                   name,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				})
+				spec.Networks = append(spec.Networks, v1.Network{
+					Name:          name,
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: name}},
+				})
+			}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+	})
+
+	Context("with the pod interface auto attachment disabled", func() {
+		const noDefaultRouteMessage = "pod interface auto attachment is disabled and no pod or default multus network is set, " +
+			"the VM has no default route"
+
+		It("should warn when only secondary networks are set", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "red",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}}
+			spec.Networks = []v1.Network{{
+				Name:          "red",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+			}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ContainElement(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: noDefaultRouteMessage,
+				Field:   "fake.domain.devices.autoattachPodInterface",
+			}))
+		})
+
+		DescribeTable("should not warn about the default route", func(iface v1.Interface, network v1.Network) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+			spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+			spec.Networks = []v1.Network{network}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).NotTo(ContainElement(HaveField("Message", noDefaultRouteMessage)))
+		},
+			Entry("with a pod network", *v1.DefaultMasqueradeNetworkInterface(), *v1.DefaultPodNetwork()),
+			Entry("with a default multus network",
+				v1.Interface{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+				v1.Network{
+					Name:          "red",
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net", Default: true}},
+				},
+			),
+		)
+
+		It("should not warn when the pod interface is auto attached", func() {
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), &v1.VirtualMachineInstanceSpec{}, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+
+		It("should warn that declared interfaces are still attached", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "pod interface auto attachment is disabled but 1 interfaces are declared, they are still attached",
+				Field:   "fake.domain.devices.autoattachPodInterface",
+			}))
+		})
+
+		It("should not warn when networking is opted out", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		})
+	})
+
+	Context("distinct network attachment definitions count", func() {
+		const maxNetworkAttachmentDefinitions = 2
+
+		DescribeTable("should validate the distinct network attachment definitions count against the limit",
+			func(networkNames []string, expectedCauses []metav1.StatusCause) {
+				spec := &v1.VirtualMachineInstanceSpec{}
+				for i, networkName := range networkNames {
+					name := fmt.Sprintf("net%d", i+1)
+					spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+						Name:                   name,
+						InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					})
+					spec.Networks = append(spec.Networks, v1.Network{
+						Name:          name,
+						NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: networkName}},
+					})
+				}
+
+				validator := admitter.NewValidator(
+					k8sfield.NewPath("fake"),
+					spec,
+					stubClusterConfigChecker{},
+					admitter.WithMaxNetworkAttachmentDefinitions(maxNetworkAttachmentDefinitions),
+				)
+				Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+			},
+			Entry("accepting when the count is at the limit", []string{"red-net", "blue-net"}, nil),
+			Entry("accepting when networks above the limit share network attachment definitions",
+				[]string{"red-net", "blue-net", "red-net"}, nil,
+			),
+			Entry("rejecting when the count is above the limit", []string{"red-net", "blue-net", "green-net"}, []metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "3 distinct network attachment definitions are referenced, at most 2 are allowed",
+				Field:   "fake.networks",
+			}}),
+		)
+
+		It("should accept any count when no limit is set", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			for i, networkName := range []string{"red-net", "blue-net", "green-net"} {
+				name := fmt.Sprintf("net%d", i+1)
+				spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+					Name:                   name,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				})
+				spec.Networks = append(spec.Networks, v1.Network{
+					Name:          name,
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: networkName}},
+				})
+			}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(BeEmpty())
+		})
+	})
 })
@@ -20,29 +20,69 @@
 package admitter
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
-func validatePasstBinding(
-	fieldPath *field.Path, idx int, iface v1.Interface, net v1.Network, config clusterConfigChecker,
-) []metav1.StatusCause {
-	var causes []metav1.StatusCause
+func validatePasstBinding(fieldPath *field.Path, idx int, iface v1.Interface, config clusterConfigChecker) []metav1.StatusCause {
 	if iface.InterfaceBindingMethod.DeprecatedPasst != nil && !config.PasstEnabled() {
-		causes = append(causes, metav1.StatusCause{
+		return []metav1.StatusCause{{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: "Passt feature gate is not enabled",
 			Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
-		})
+		}}
 	}
-	if iface.DeprecatedPasst != nil && net.Pod == nil {
-		causes = append(causes, metav1.StatusCause{
+	return nil
+}
+
+func validatePasstWithSlirpBinding(fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	slirpIfaces := vmispec.FilterInterfacesSpec(spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
+		return iface.DeprecatedSlirp != nil
+	})
+	passtIfaces := vmispec.FilterInterfacesSpec(spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
+		return iface.DeprecatedPasst != nil
+	})
+	if len(slirpIfaces) > 0 && len(passtIfaces) > 0 {
+		return []metav1.StatusCause{{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "Passt interface only implemented with pod network",
-			Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
-		})
+			Message: "Slirp and Passt bindings are both used, only one userspace binding is expected per VMI",
+			Field:   fieldPath.Child("domain", "devices", "interfaces").String(),
+		}}
+	}
+	return nil
+}
+
+// validatePasstSolePodNetworkInterface rejects interfaces connected to the pod network alongside a passt interface,
+// passt takes over the pod network entirely and cannot share it with another binding.
+func validatePasstSolePodNetworkInterface(fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	networksByName := vmispec.IndexNetworkSpecByName(spec.Networks)
+	isOnPodNetwork := func(iface v1.Interface) bool {
+		network, exists := networksByName[iface.Name]
+		return exists && network.Pod != nil
+	}
+	passtIfaces := vmispec.FilterInterfacesSpec(spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
+		return iface.DeprecatedPasst != nil && isOnPodNetwork(iface)
+	})
+	if len(passtIfaces) == 0 {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.DeprecatedPasst == nil && isOnPodNetwork(iface) {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Passt interface %q claims the pod network, interface %q cannot be connected to it as well",
+					passtIfaces[0].Name, iface.Name),
+				Field: fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			})
+		}
 	}
 	return causes
 }
@@ -28,6 +28,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/network/admitter"
 )
 
@@ -84,4 +85,74 @@ var _ = Describe("Validating passt core binding", func() {
 		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
 		Expect(validator.Validate()).To(BeEmpty())
 	})
+
+	DescribeTable("should reject an interface connected to a pod network alongside passt", func(binding v1.InterfaceBindingMethod) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{
+			{
+				Name:                   "default",
+				InterfaceBindingMethod: binding,
+			},
+			{
+				Name:                   "secondary",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{DeprecatedPasst: &v1.DeprecatedInterfacePasst{}},
+			},
+		}
+		spec.Networks = []v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: "secondary", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+		}
+
+		clusterConfig := stubClusterConfigChecker{passtFeatureGateEnabled: true, bridgeBindingOnPodNetEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+		Expect(validator.Validate()).To(ConsistOf(
+			metav1.StatusCause{
+				Type:    "FieldValueDuplicate",
+				Message: "more than one interface is connected to a pod network in fake.interfaces",
+				Field:   "fake.interfaces",
+			},
+			metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "Passt interface \"secondary\" claims the pod network, interface \"default\" cannot be connected to it as well",
+				Field:   "fake.domain.devices.interfaces[0].name",
+			},
+		))
+	},
+		Entry("masquerade", v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}),
+		Entry("bridge", v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}),
+	)
+
+	It("should warn when both passt and slirp interfaces are used", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{
+			{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{DeprecatedPasst: &v1.DeprecatedInterfacePasst{}},
+			},
+			{
+				Name:                   "secondary",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{DeprecatedSlirp: &v1.DeprecatedInterfaceSlirp{}},
+			},
+		}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork(), *libvmi.MultusNetwork("secondary", "test")}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "Slirp and Passt bindings are both used, only one userspace binding is expected per VMI",
+			Field:   "fake.domain.devices.interfaces",
+		}))
+	})
+
+	DescribeTable("should not warn when a single userspace binding is used", func(binding v1.InterfaceBindingMethod) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", InterfaceBindingMethod: binding}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	},
+		Entry("passt", v1.InterfaceBindingMethod{DeprecatedPasst: &v1.DeprecatedInterfacePasst{}}),
+		Entry("slirp", v1.InterfaceBindingMethod{DeprecatedSlirp: &v1.DeprecatedInterfaceSlirp{}}),
+	)
 })
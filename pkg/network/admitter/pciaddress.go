@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+const rootPciBus = "0000:00"
+
+// diskControllerRootBusSlots maps the root bus slots conventionally taken by the machine type disk controllers.
+var diskControllerRootBusSlots = map[string]string{
+	"01": "i440fx IDE",
+	"1f": "q35 SATA",
+}
+
+// mandatoryDeviceRootBusSlots maps the root bus slots virt-launcher skips when placing devices on the root complex,
+// as they are taken by the host bridge and the devices and controllers QEMU adds to the machine.
+var mandatoryDeviceRootBusSlots = map[string]string{
+	"00": "host bridge",
+	"01": "VGA controller",
+	"1b": "ich9 sound card",
+	"1f": "SATA controller",
+}
+
+// validateRootBusSlotsForMandatoryDevices warns on interfaces pinned to a root bus slot taken by a mandatory device.
+// The pod network interface on a disk controller slot is already reported by
+// validatePodInterfacePciAddressNotOnDiskControllerSlot.
+func validateRootBusSlotsForMandatoryDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	podNetwork := vmispec.LookupPodNetwork(spec.Networks)
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.PciAddress == "" {
+			continue
+		}
+		pciAddrParts, err := hwutil.ParsePciAddress(iface.PciAddress)
+		if err != nil {
+			continue
+		}
+		domain, bus, slot := pciAddrParts[0], pciAddrParts[1], strings.ToLower(pciAddrParts[2])
+		device, reserved := mandatoryDeviceRootBusSlots[slot]
+		if strings.ToLower(domain+":"+bus) != rootPciBus || !reserved {
+			continue
+		}
+		if _, diskControllerSlot := diskControllerRootBusSlots[slot]; diskControllerSlot && podNetwork != nil && iface.Name == podNetwork.Name {
+			continue
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interface %q PCI address %s collides with the root bus slot taken by the %s",
+				iface.Name, iface.PciAddress, device),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("pciAddress").String(),
+		})
+	}
+	return causes
+}
+
+// validatePodInterfacePciAddressNotOnDiskControllerSlot warns when the pod network interface is pinned to
+// a root bus slot conventionally taken by a disk controller, which prevents the disks from being attached.
+func validatePodInterfacePciAddressNotOnDiskControllerSlot(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	podNetwork := vmispec.LookupPodNetwork(spec.Networks)
+	if podNetwork == nil {
+		return nil
+	}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Name != podNetwork.Name || iface.PciAddress == "" {
+			continue
+		}
+		pciAddrParts, err := hwutil.ParsePciAddress(iface.PciAddress)
+		if err != nil {
+			return nil
+		}
+		domain, bus, slot := pciAddrParts[0], pciAddrParts[1], strings.ToLower(pciAddrParts[2])
+		controller, reserved := diskControllerRootBusSlots[slot]
+		if strings.ToLower(domain+":"+bus) != rootPciBus || !reserved {
+			return nil
+		}
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("pod network interface %q PCI address %s collides with the slot conventionally used by the %s disk controller",
+				iface.Name, iface.PciAddress, controller),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("pciAddress").String(),
+		}}
+	}
+	return nil
+}
+
+// canonicalPciAddress returns the lowercase form of a PCI address, which libvirt and the guest report.
+func canonicalPciAddress(pciAddress string) string {
+	return strings.ToLower(pciAddress)
+}
+
+func validateInterfacePciAddressUnique(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	ifaceNameByPciAddress := map[string]string{}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.PciAddress == "" {
+			continue
+		}
+		pciAddress := canonicalPciAddress(iface.PciAddress)
+		if otherIfaceName, exists := ifaceNameByPciAddress[pciAddress]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("interface %q PCI address %s is already used by interface %q", iface.Name, iface.PciAddress, otherIfaceName),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("pciAddress").String(),
+			})
+			continue
+		}
+		ifaceNameByPciAddress[pciAddress] = iface.Name
+	}
+	return causes
+}
+
+// validatePciAddressLowercase warns on PCI addresses not set in their canonical lowercase form.
+func validatePciAddressLowercase(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.PciAddress != canonicalPciAddress(iface.PciAddress) {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q PCI address %s is not lowercase, consider using %s",
+					iface.Name, iface.PciAddress, canonicalPciAddress(iface.PciAddress)),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("pciAddress").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validateACPIIndexWithPciAddress warns on interfaces setting both a PCI address and an ACPI index.
+// The guest derives the predictable interface name from the ACPI index first, ignoring the PCI slot.
+func validateACPIIndexWithPciAddress(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.PciAddress != "" && iface.ACPIIndex != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q sets both a PCI address and an ACPI index, the ACPI index takes precedence in the guest interface name",
+					iface.Name),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("acpiIndex").String(),
+			})
+		}
+	}
+	return causes
+}
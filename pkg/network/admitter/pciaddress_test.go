@@ -0,0 +1,187 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface PCI address", func() {
+	DescribeTable("should warn on an interface pinned to a root bus slot taken by a mandatory device", func(pciAddress, device string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "red",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			PciAddress:             pciAddress,
+		}}
+		spec.Networks = []v1.Network{{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: fmt.Sprintf("interface \"red\" PCI address %s collides with the root bus slot taken by the %s", pciAddress, device),
+			Field:   "fake.domain.devices.interfaces[0].pciAddress",
+		}))
+	},
+		Entry("host bridge", "0000:00:00.0", "host bridge"),
+		Entry("VGA controller", "0000:00:01.0", "VGA controller"),
+		Entry("sound card", "0000:00:1b.0", "ich9 sound card"),
+		Entry("SATA controller", "0000:00:1f.0", "SATA controller"),
+	)
+
+	DescribeTable("should not warn on an interface pinned to a slot free of mandatory devices", func(pciAddress string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "red",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			PciAddress:             pciAddress,
+		}}
+		spec.Networks = []v1.Network{{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	},
+		Entry("on the root bus", "0000:00:02.0"),
+		Entry("on another bus", "0000:01:1f.0"),
+	)
+
+	Context("with an ACPI index", func() {
+		DescribeTable("should validate the ACPI index along the PCI address", func(
+			pciAddress string, acpiIndex int, expectedCauses []metav1.StatusCause,
+		) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "red",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				PciAddress:             pciAddress,
+				ACPIIndex:              acpiIndex,
+			}}
+			spec.Networks = []v1.Network{{
+				Name:          "red",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+			}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(expectedCauses))
+		},
+			Entry("warning when both the PCI address and the ACPI index are set", "0000:01:00.0", 2, []metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface \"red\" sets both a PCI address and an ACPI index, the ACPI index takes precedence in the guest interface name",
+				Field:   "fake.domain.devices.interfaces[0].acpiIndex",
+			}}),
+			Entry("not warning when only the PCI address is set", "0000:01:00.0", 0, nil),
+			Entry("not warning when only the ACPI index is set", "", 2, nil),
+		)
+	})
+
+	Context("pod network interface on a disk controller slot", func() {
+		DescribeTable("should validate the pod interface PCI address", func(pciAddress string, expectedCauses []metav1.StatusCause) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			spec.Domain.Devices.Interfaces[0].PciAddress = pciAddress
+			spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(expectedCauses))
+		},
+			Entry("warning when the pod interface is pinned to the reserved slot", "0000:00:1F.0", []metav1.StatusCause{
+				{
+					Type: "FieldValueInvalid",
+					Message: "pod network interface \"default\" PCI address 0000:00:1F.0 collides with the slot " +
+						"conventionally used by the q35 SATA disk controller",
+					Field: "fake.domain.devices.interfaces[0].pciAddress",
+				},
+				{
+					Type:    "FieldValueInvalid",
+					Message: "interface \"default\" PCI address 0000:00:1F.0 is not lowercase, consider using 0000:00:1f.0",
+					Field:   "fake.domain.devices.interfaces[0].pciAddress",
+				},
+			}),
+			Entry("not warning when the pod interface is pinned to a free root bus slot", "0000:00:05.0", nil),
+			Entry("not warning when the pod interface is pinned to a non root bus", "0000:01:1f.0", nil),
+		)
+	})
+
+	Context("uniqueness and case", func() {
+		DescribeTable("should validate the PCI addresses uniqueness", func(pciAddresses []string, expectedCauses []metav1.StatusCause) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			for i, pciAddress := range pciAddresses {
+				name := fmt.Sprintf("net%d", i+1)
+				spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+					Name:                   name,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+					PciAddress:             pciAddress,
+				})
+				spec.Networks = append(spec.Networks, v1.Network{
+					Name:          name,
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: name}},
+				})
+			}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+		},
+			Entry("rejecting interfaces sharing a PCI address", []string{"0000:01:00.0", "0000:01:00.0"}, []metav1.StatusCause{{
+				Type:    "FieldValueDuplicate",
+				Message: "interface \"net2\" PCI address 0000:01:00.0 is already used by interface \"net1\"",
+				Field:   "fake.domain.devices.interfaces[1].pciAddress",
+			}}),
+			Entry("rejecting interfaces sharing a PCI address in a different case", []string{"0000:0a:1f.0", "0000:0A:1F.0"}, []metav1.StatusCause{{
+				Type:    "FieldValueDuplicate",
+				Message: "interface \"net2\" PCI address 0000:0A:1F.0 is already used by interface \"net1\"",
+				Field:   "fake.domain.devices.interfaces[1].pciAddress",
+			}}),
+			Entry("accepting interfaces with distinct PCI addresses", []string{"0000:01:00.0", "0000:02:00.0", ""}, nil),
+		)
+
+		DescribeTable("should validate the PCI address case", func(pciAddress string, expectedCauses []metav1.StatusCause) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "net1",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				PciAddress:             pciAddress,
+			}}
+			spec.Networks = []v1.Network{{
+				Name:          "net1",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net1"}},
+			}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateWarnings()).To(ConsistOf(expectedCauses))
+		},
+			Entry("warning on a PCI address which is not lowercase", "0000:0A:1F.0", []metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface \"net1\" PCI address 0000:0A:1F.0 is not lowercase, consider using 0000:0a:1f.0",
+				Field:   "fake.domain.devices.interfaces[0].pciAddress",
+			}}),
+			Entry("not warning on a lowercase PCI address", "0000:0a:1f.0", nil),
+		)
+	})
+})
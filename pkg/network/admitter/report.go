@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// severity tells whether a validation result rejects the spec or is only reported back as a warning.
+type severity string
+
+const (
+	severityError   severity = "error"
+	severityWarning severity = "warning"
+)
+
+type validationResult struct {
+	Severity severity         `json:"severity"`
+	Type     metav1.CauseType `json:"type"`
+	Field    string           `json:"field"`
+	Message  string           `json:"message"`
+}
+
+type validationReport struct {
+	Results []validationResult `json:"results"`
+}
+
+// ValidateJSON runs the spec validation and the warnings validation, returning their outcome as a JSON report
+// for tooling such as CI gates and linters. The report holds a "results" list, errors first, each result carrying
+// its "severity" ("error" or "warning") along with the "type", "field" and "message" of the cause.
+func (v Validator) ValidateJSON() ([]byte, error) {
+	report := validationReport{Results: []validationResult{}}
+	report.Results = append(report.Results, toValidationResults(severityError, v.Validate())...)
+	report.Results = append(report.Results, toValidationResults(severityWarning, v.ValidateWarnings())...)
+	return json.Marshal(report)
+}
+
+func toValidationResults(resultSeverity severity, causes []metav1.StatusCause) []validationResult {
+	var results []validationResult
+	for _, cause := range causes {
+		results = append(results, validationResult{
+			Severity: resultSeverity,
+			Type:     cause.Type,
+			Field:    cause.Field,
+			Message:  cause.Message,
+		})
+	}
+	return results
+}
@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validation JSON report", func() {
+	It("should report errors and warnings with their severity", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{
+			{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Model:                  "invalid_model",
+			},
+			{
+				Name:                   "sriov",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+				Ports:                  []v1.Port{{Port: 80}},
+			},
+		}
+		spec.Networks = []v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: "sriov", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}}},
+		}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateJSON()).To(MatchJSON(`{"results": [
+			{
+				"severity": "error",
+				"type": "FieldValueNotSupported",
+				"field": "fake.domain.devices.interfaces[0].model",
+				"message": "interface fake.domain.devices.interfaces[0].name uses model invalid_model that is not supported."
+			},
+			{
+				"severity": "warning",
+				"type": "FieldValueInvalid",
+				"field": "fake.domain.devices.interfaces[1].ports",
+				"message": "interface \"sriov\" ports cannot be exposed by a Service due to its binding"
+			}
+		]}`))
+	})
+
+	It("should report an empty result list for a valid spec", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateJSON()).To(MatchJSON(`{"results": []}`))
+	})
+})
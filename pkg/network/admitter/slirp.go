@@ -46,13 +46,7 @@ func validateSlirpBinding(
 			continue
 		}
 
-		if net.Pod == nil {
-			causes = append(causes, metav1.StatusCause{
-				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Slirp interface only implemented with pod network",
-				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
-			})
-		} else if !configChecker.IsSlirpInterfaceEnabled() {
+		if net.Pod != nil && !configChecker.IsSlirpInterfaceEnabled() {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: "Slirp interface is not enabled in kubevirt-config",
@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// DefaultMaxNetworkSpecSize is the serialized size in bytes of the interfaces and networks
+// above which a warning is reported, unless overridden.
+const DefaultMaxNetworkSpecSize = 128 * 1024
+
+// WithMaxNetworkSpecSize overrides the serialized size in bytes of the interfaces and networks
+// above which a warning is reported, zero disables it.
+func WithMaxNetworkSpecSize(maxSize int) option {
+	return func(v *Validator) {
+		v.maxNetworkSpecSize = maxSize
+	}
+}
+
+// validateNetworkSpecSize warns when the interfaces and networks take a large part of the object stored
+// in etcd, which caps the size of a single object.
+func validateNetworkSpecSize(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, maxSize int) []metav1.StatusCause {
+	if maxSize <= 0 {
+		return nil
+	}
+	networkSpec := struct {
+		Interfaces []v1.Interface `json:"interfaces,omitempty"`
+		Networks   []v1.Network   `json:"networks,omitempty"`
+	}{
+		Interfaces: spec.Domain.Devices.Interfaces,
+		Networks:   spec.Networks,
+	}
+	serialized, err := json.Marshal(networkSpec)
+	if err != nil {
+		return nil
+	}
+	if len(serialized) > maxSize {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interfaces and networks take %d bytes once serialized, more than the %d bytes recommended",
+				len(serialized), maxSize),
+			Field: field.String(),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating network spec size", func() {
+	DescribeTable("should warn on the spec size", func(interfaces, portsPerInterface, maxSpecSize int, matcher types.GomegaMatcher) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		for i := 0; i < interfaces; i++ {
+			name := fmt.Sprintf("iface%d", i)
+			iface := v1.Interface{
+				Name:                   name,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}
+			for p := 0; p < portsPerInterface; p++ {
+				iface.Ports = append(iface.Ports, v1.Port{Name: fmt.Sprintf("port%d", p), Port: int32(1000 + p)})
+			}
+			spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, iface)
+			spec.Networks = append(spec.Networks, v1.Network{
+				Name:          name,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "network-attachment-" + name}},
+			})
+		}
+
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithMaxNetworkSpecSize(maxSpecSize),
+		)
+		Expect(validator.ValidateWarnings()).To(matcher)
+	},
+		Entry("when the interfaces and networks are too large", 500, 20, admitter.DefaultMaxNetworkSpecSize, ContainElement(And(
+			HaveField("Type", BeEquivalentTo("FieldValueInvalid")),
+			HaveField("Message", HaveSuffix("bytes once serialized, more than the 131072 bytes recommended")),
+			HaveField("Field", "fake"),
+		))),
+		Entry("not on a common number of interfaces and networks", 4, 2, admitter.DefaultMaxNetworkSpecSize, BeEmpty()),
+		Entry("not when the size limit is disabled", 500, 20, 0, BeEmpty()),
+	)
+})
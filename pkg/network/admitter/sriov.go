@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// WithSRIOVResourceRequests sets the resource name serving each network and the resources requested by the pod,
+// used to verify the resource names are qualified and enough VFs are requested for the SR-IOV interfaces.
+func WithSRIOVResourceRequests(networkToResourceMap map[string]string, requests k8scorev1.ResourceList) option {
+	return func(v *Validator) {
+		v.networkToResourceMap = networkToResourceMap
+		v.resourceRequests = requests
+	}
+}
+
+func validateSRIOVResourceRequests(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, networkToResourceMap map[string]string, requests k8scorev1.ResourceList,
+) []metav1.StatusCause {
+	if networkToResourceMap == nil {
+		return nil
+	}
+	sriovInterfacesCountByResource := map[string]int64{}
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		if iface.SRIOV == nil {
+			continue
+		}
+		if resourceName := networkToResourceMap[iface.Name]; resourceName != "" {
+			sriovInterfacesCountByResource[resourceName]++
+		}
+	}
+
+	resourceNames := make([]string, 0, len(sriovInterfacesCountByResource))
+	for resourceName := range sriovInterfacesCountByResource {
+		resourceNames = append(resourceNames, resourceName)
+	}
+	sort.Strings(resourceNames)
+
+	var causes []metav1.StatusCause
+	for _, resourceName := range resourceNames {
+		requested := requests[k8scorev1.ResourceName(resourceName)]
+		if count := sriovInterfacesCountByResource[resourceName]; count > requested.Value() {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%d SR-IOV interfaces use resource %s but only %d VFs are requested",
+					count, resourceName, requested.Value()),
+				Field: field.Child("domain", "devices", "interfaces").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateSRIOVResourceNames(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, networkToResourceMap map[string]string,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		resourceName := networkToResourceMap[iface.Name]
+		if iface.SRIOV == nil || resourceName == "" {
+			continue
+		}
+		errs := k8svalidation.IsQualifiedName(resourceName)
+		if !strings.Contains(resourceName, "/") {
+			errs = append(errs, "a DNS-1123 subdomain prefix is required (e.g. 'example.com/name')")
+		}
+		if len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q SR-IOV resource name %q is not a qualified name: %s",
+					iface.Name, resourceName, strings.Join(errs, ", ")),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			})
+		}
+	}
+	return causes
+}
@@ -0,0 +1,115 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating SR-IOV interfaces", func() {
+	const sriovResourceName = "intel.com/sriov_net"
+
+	var spec *v1.VirtualMachineInstanceSpec
+	var networkToResourceMap map[string]string
+
+	BeforeEach(func() {
+		spec = &v1.VirtualMachineInstanceSpec{}
+		for _, name := range []string{"sriov1", "sriov2"} {
+			spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+				Name:                   name,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			})
+			spec.Networks = append(spec.Networks, v1.Network{
+				Name:          name,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: name + "-net"}},
+			})
+		}
+		networkToResourceMap = map[string]string{"sriov1": sriovResourceName, "sriov2": sriovResourceName}
+	})
+
+	It("should accept when the requested VFs match the SR-IOV interfaces", func() {
+		requests := k8scorev1.ResourceList{sriovResourceName: resource.MustParse("2")}
+
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithSRIOVResourceRequests(networkToResourceMap, requests),
+		)
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject when the SR-IOV interfaces exceed the requested VFs", func() {
+		requests := k8scorev1.ResourceList{sriovResourceName: resource.MustParse("1")}
+
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithSRIOVResourceRequests(networkToResourceMap, requests),
+		)
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "2 SR-IOV interfaces use resource intel.com/sriov_net but only 1 VFs are requested",
+			Field:   "fake.domain.devices.interfaces",
+		}))
+	})
+
+	DescribeTable("should accept a qualified resource name", func(resourceName string) {
+		networkToResourceMap = map[string]string{"sriov1": resourceName, "sriov2": resourceName}
+		requests := k8scorev1.ResourceList{k8scorev1.ResourceName(resourceName): resource.MustParse("2")}
+
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithSRIOVResourceRequests(networkToResourceMap, requests),
+		)
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("with a vendor prefix", "intel.com/sriov_net"),
+		Entry("with a subdomain prefix", "openshift.io/mlx-sriov"),
+	)
+
+	DescribeTable("should reject a malformed resource name", func(resourceName string) {
+		networkToResourceMap = map[string]string{"sriov1": resourceName}
+		spec.Domain.Devices.Interfaces = spec.Domain.Devices.Interfaces[:1]
+		spec.Networks = spec.Networks[:1]
+		requests := k8scorev1.ResourceList{k8scorev1.ResourceName(resourceName): resource.MustParse("1")}
+
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}, admitter.WithSRIOVResourceRequests(networkToResourceMap, requests),
+		)
+		causes := validator.Validate()
+		Expect(causes).To(HaveLen(1))
+		Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
+		Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].name"))
+		Expect(causes[0].Message).To(HavePrefix(
+			fmt.Sprintf("interface \"sriov1\" SR-IOV resource name %q is not a qualified name: ", resourceName),
+		))
+	},
+		Entry("without a prefix", "sriov_net"),
+		Entry("with an invalid prefix", "Intel_com/sriov_net"),
+		Entry("with an invalid name", "intel.com/sriov net"),
+	)
+})
@@ -0,0 +1,228 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// InterfaceModelChangeAcknowledgedAnnotation acknowledges that an interface model change takes effect after a reboot.
+const InterfaceModelChangeAcknowledgedAnnotation = "kubevirt.io/interface-model-change-acknowledged"
+
+// WithAnnotations sets the VMI annotations, used to look up acknowledgements of disruptive updates.
+func WithAnnotations(annotations map[string]string) option {
+	return func(v *Validator) {
+		v.annotations = annotations
+	}
+}
+
+// WithInterfaceStatuses sets the VMI interfaces status, used to reconcile the reported interfaces with the spec.
+func WithInterfaceStatuses(ifaceStatuses []v1.VirtualMachineInstanceNetworkInterface) option {
+	return func(v *Validator) {
+		v.ifaceStatuses = ifaceStatuses
+	}
+}
+
+func validateInterfaceHotplugEnabled(
+	field *k8sfield.Path, oldSpec, newSpec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	if config.HotplugNetworkInterfacesEnabled() {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	oldIfacesByName := vmispec.IndexInterfaceSpecByName(oldSpec.Domain.Devices.Interfaces)
+	for idx, iface := range newSpec.Domain.Devices.Interfaces {
+		if _, exists := oldIfacesByName[iface.Name]; !exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q cannot be hotplugged, the HotplugNICs feature gate is not enabled", iface.Name),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateHotpluggedNetworkNameCollision(field *k8sfield.Path, oldSpec, newSpec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	oldNetworksByName := vmispec.IndexNetworkSpecByName(oldSpec.Networks)
+	for idx, network := range newSpec.Networks {
+		oldNetwork, exists := oldNetworksByName[network.Name]
+		if exists && !equality.Semantic.DeepEqual(oldNetwork.NetworkSource, network.NetworkSource) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("network name %q is already used by an existing network", network.Name),
+				Field:   field.Child("networks").Index(idx).Child("name").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateRemovedInterfaceNetworks(field *k8sfield.Path, oldSpec, newSpec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	newIfacesByName := vmispec.IndexInterfaceSpecByName(newSpec.Domain.Devices.Interfaces)
+	for _, oldIface := range oldSpec.Domain.Devices.Interfaces {
+		if _, exists := newIfacesByName[oldIface.Name]; exists {
+			continue
+		}
+		for idx, network := range newSpec.Networks {
+			if network.Name == oldIface.Name {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("interface %q is removed but its network is kept", oldIface.Name),
+					Field:   field.Child("networks").Index(idx).Child("name").String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+func validateInterfaceMacAddressUnchanged(field *k8sfield.Path, oldSpec, newSpec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	oldIfacesByName := vmispec.IndexInterfaceSpecByName(oldSpec.Domain.Devices.Interfaces)
+	for idx, iface := range newSpec.Domain.Devices.Interfaces {
+		oldIface, exists := oldIfacesByName[iface.Name]
+		if exists && oldIface.MacAddress != iface.MacAddress {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q MAC address cannot be changed", iface.Name),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateInterfaceBindingUnchanged(field *k8sfield.Path, oldSpec, newSpec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	oldIfacesByName := vmispec.IndexInterfaceSpecByName(oldSpec.Domain.Devices.Interfaces)
+	for idx, iface := range newSpec.Domain.Devices.Interfaces {
+		oldIface, exists := oldIfacesByName[iface.Name]
+		if !exists {
+			continue
+		}
+		if !equality.Semantic.DeepEqual(oldIface.InterfaceBindingMethod, iface.InterfaceBindingMethod) ||
+			!equality.Semantic.DeepEqual(oldIface.Binding, iface.Binding) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q binding cannot be changed", iface.Name),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateInterfacePciAddressUnchanged(field *k8sfield.Path, oldSpec, newSpec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	oldIfacesByName := vmispec.IndexInterfaceSpecByName(oldSpec.Domain.Devices.Interfaces)
+	for idx, iface := range newSpec.Domain.Devices.Interfaces {
+		oldIface, exists := oldIfacesByName[iface.Name]
+		if exists && canonicalPciAddress(oldIface.PciAddress) != canonicalPciAddress(iface.PciAddress) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q PCI address cannot be changed", iface.Name),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("pciAddress").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateInterfaceModelChangeAcknowledged(
+	field *k8sfield.Path, oldSpec, newSpec *v1.VirtualMachineInstanceSpec, annotations map[string]string,
+) []metav1.StatusCause {
+	if _, acknowledged := annotations[InterfaceModelChangeAcknowledgedAnnotation]; acknowledged {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	oldIfacesByName := vmispec.IndexInterfaceSpecByName(oldSpec.Domain.Devices.Interfaces)
+	for idx, iface := range newSpec.Domain.Devices.Interfaces {
+		oldIface, exists := oldIfacesByName[iface.Name]
+		if exists && oldIface.Model != iface.Model {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q model change requires a reboot, acknowledge it with the %s annotation",
+					iface.Name, InterfaceModelChangeAcknowledgedAnnotation),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("model").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateReportedInterfacesRemoval(
+	field *k8sfield.Path, oldSpec, newSpec *v1.VirtualMachineInstanceSpec, ifaceStatuses []v1.VirtualMachineInstanceNetworkInterface,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	oldIfacesByName := vmispec.IndexInterfaceSpecByName(oldSpec.Domain.Devices.Interfaces)
+	newIfacesByName := vmispec.IndexInterfaceSpecByName(newSpec.Domain.Devices.Interfaces)
+	for _, ifaceStatus := range ifaceStatuses {
+		if ifaceStatus.Name == "" {
+			continue
+		}
+		oldIface, existedBefore := oldIfacesByName[ifaceStatus.Name]
+		if _, exists := newIfacesByName[ifaceStatus.Name]; exists || !existedBefore {
+			continue
+		}
+		if oldIface.State != v1.InterfaceStateAbsent {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %q is reported in status and cannot be removed before it is marked absent", ifaceStatus.Name),
+				Field:   field.Child("domain", "devices", "interfaces").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validatePodNetworkPositionUnchanged warns when the pod network moves in the networks list,
+// as guests commonly enumerate the interfaces in this order and may rename them.
+func validatePodNetworkPositionUnchanged(field *k8sfield.Path, oldSpec, newSpec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	oldIdx, newIdx := podNetworkIndex(oldSpec.Networks), podNetworkIndex(newSpec.Networks)
+	if oldIdx == -1 || newIdx == -1 || oldIdx == newIdx {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type: metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("pod network %q moved from index %d to %d, which may change the guest interface names",
+			newSpec.Networks[newIdx].Name, oldIdx, newIdx),
+		Field: field.Child("networks").Index(newIdx).String(),
+	}}
+}
+
+func podNetworkIndex(networks []v1.Network) int {
+	for idx, network := range networks {
+		if network.Pod != nil {
+			return idx
+		}
+	}
+	return -1
+}
@@ -0,0 +1,264 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating VMI network spec update", func() {
+	var oldVMI *v1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		oldVMI = libvmi.New(
+			libvmi.WithInterface(*v1.DefaultMasqueradeNetworkInterface()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "red",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+		)
+	})
+
+	It("should accept a hotplugged network with a new name", func() {
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces = append(newVMI.Spec.Domain.Devices.Interfaces, v1.Interface{
+			Name:                   "blue",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		})
+		newVMI.Spec.Networks = append(newVMI.Spec.Networks, *libvmi.MultusNetwork("blue", "blue-net"))
+
+		clusterConfig := stubClusterConfigChecker{hotplugNICsFGEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, clusterConfig)
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(BeEmpty())
+	})
+
+	It("should reject a hotplugged interface when the HotplugNICs feature gate is disabled", func() {
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces = append(newVMI.Spec.Domain.Devices.Interfaces, v1.Interface{
+			Name:                   "blue",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		})
+		newVMI.Spec.Networks = append(newVMI.Spec.Networks, *libvmi.MultusNetwork("blue", "blue-net"))
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface \"blue\" cannot be hotplugged, the HotplugNICs feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[2]",
+		}))
+	})
+
+	It("should reject a hotplugged network reusing the name of an existing network", func() {
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Networks[1] = *libvmi.MultusNetwork("red", "blue-net")
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueDuplicate",
+			Message: "network name \"red\" is already used by an existing network",
+			Field:   "fake.networks[1].name",
+		}))
+	})
+
+	It("should reject an interface removal which keeps its network", func() {
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces = newVMI.Spec.Domain.Devices.Interfaces[:1]
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface \"red\" is removed but its network is kept",
+			Field:   "fake.networks[1].name",
+		}))
+	})
+
+	It("should accept an interface removal together with its network", func() {
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces = newVMI.Spec.Domain.Devices.Interfaces[:1]
+		newVMI.Spec.Networks = newVMI.Spec.Networks[:1]
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(BeEmpty())
+	})
+
+	It("should reject a MAC address change of an existing interface", func() {
+		oldVMI.Spec.Domain.Devices.Interfaces[1].MacAddress = "02:00:00:00:00:01"
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces[1].MacAddress = "02:00:00:00:00:02"
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface \"red\" MAC address cannot be changed",
+			Field:   "fake.domain.devices.interfaces[1].macAddress",
+		}))
+	})
+
+	It("should accept an unchanged MAC address of an existing interface", func() {
+		oldVMI.Spec.Domain.Devices.Interfaces[1].MacAddress = "02:00:00:00:00:01"
+		newVMI := oldVMI.DeepCopy()
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(BeEmpty())
+	})
+
+	It("should reject a binding change of an existing interface", func() {
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = v1.InterfaceBindingMethod{
+			Bridge: &v1.InterfaceBridge{},
+		}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface \"default\" binding cannot be changed",
+			Field:   "fake.domain.devices.interfaces[0]",
+		}))
+	})
+
+	It("should accept an unchanged binding of an existing interface", func() {
+		newVMI := oldVMI.DeepCopy()
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(BeEmpty())
+	})
+
+	It("should reject a PCI address change of an existing interface", func() {
+		oldVMI.Spec.Domain.Devices.Interfaces[1].PciAddress = "0000:81:01.0"
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces[1].PciAddress = "0000:81:02.0"
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface \"red\" PCI address cannot be changed",
+			Field:   "fake.domain.devices.interfaces[1].pciAddress",
+		}))
+	})
+
+	It("should accept an unchanged PCI address of an existing interface", func() {
+		oldVMI.Spec.Domain.Devices.Interfaces[1].PciAddress = "0000:81:01.0"
+		newVMI := oldVMI.DeepCopy()
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(BeEmpty())
+	})
+
+	It("should accept a PCI address of an existing interface changed only in case", func() {
+		oldVMI.Spec.Domain.Devices.Interfaces[1].PciAddress = "0000:81:0a.0"
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces[1].PciAddress = "0000:81:0A.0"
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(BeEmpty())
+	})
+
+	It("should reject a model change of an existing interface without acknowledgement", func() {
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces[1].Model = "e1000e"
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(ConsistOf(metav1.StatusCause{
+			Type: "FieldValueInvalid",
+			Message: "interface \"red\" model change requires a reboot, " +
+				"acknowledge it with the kubevirt.io/interface-model-change-acknowledged annotation",
+			Field: "fake.domain.devices.interfaces[1].model",
+		}))
+	})
+
+	It("should accept a model change of an existing interface with acknowledgement", func() {
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces[1].Model = "e1000e"
+		annotations := map[string]string{admitter.InterfaceModelChangeAcknowledgedAnnotation: ""}
+
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{}, admitter.WithAnnotations(annotations),
+		)
+		Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(BeEmpty())
+	})
+
+	Context("with reported interfaces status", func() {
+		ifaceStatuses := []v1.VirtualMachineInstanceNetworkInterface{{Name: "default"}, {Name: "red"}}
+
+		It("should reject the removal of a reported interface which is not marked absent", func() {
+			newVMI := oldVMI.DeepCopy()
+			newVMI.Spec.Domain.Devices.Interfaces = newVMI.Spec.Domain.Devices.Interfaces[:1]
+			newVMI.Spec.Networks = newVMI.Spec.Networks[:1]
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{}, admitter.WithInterfaceStatuses(ifaceStatuses),
+			)
+			Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "interface \"red\" is reported in status and cannot be removed before it is marked absent",
+				Field:   "fake.domain.devices.interfaces",
+			}))
+		})
+
+		It("should accept the removal of a reported interface which is marked absent", func() {
+			oldVMI.Spec.Domain.Devices.Interfaces[1].State = v1.InterfaceStateAbsent
+			newVMI := oldVMI.DeepCopy()
+			newVMI.Spec.Domain.Devices.Interfaces = newVMI.Spec.Domain.Devices.Interfaces[:1]
+			newVMI.Spec.Networks = newVMI.Spec.Networks[:1]
+
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{}, admitter.WithInterfaceStatuses(ifaceStatuses),
+			)
+			Expect(validator.ValidateUpdate(&oldVMI.Spec)).To(BeEmpty())
+		})
+	})
+
+	Context("pod network position", func() {
+		It("should warn when the pod network is moved", func() {
+			newVMI := oldVMI.DeepCopy()
+			newVMI.Spec.Networks[0], newVMI.Spec.Networks[1] = newVMI.Spec.Networks[1], newVMI.Spec.Networks[0]
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateUpdateWarnings(&oldVMI.Spec)).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "pod network \"default\" moved from index 0 to 1, which may change the guest interface names",
+				Field:   "fake.networks[1]",
+			}))
+		})
+
+		It("should not warn when the pod network keeps its position", func() {
+			newVMI := oldVMI.DeepCopy()
+			newVMI.Spec.Domain.Devices.Interfaces = append(newVMI.Spec.Domain.Devices.Interfaces, v1.Interface{
+				Name:                   "blue",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			})
+			newVMI.Spec.Networks = append(newVMI.Spec.Networks, *libvmi.MultusNetwork("blue", "blue-net"))
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), &newVMI.Spec, stubClusterConfigChecker{})
+			Expect(validator.ValidateUpdateWarnings(&oldVMI.Spec)).To(BeEmpty())
+		})
+	})
+})
@@ -20,6 +20,9 @@
 package admitter
 
 import (
+	"fmt"
+
+	k8scorev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -34,47 +37,196 @@ type clusterConfigChecker interface {
 	MacvtapEnabled() bool
 	PasstEnabled() bool
 	NetworkBindingPlugingsEnabled() bool
+	HotplugNetworkInterfacesEnabled() bool
 }
 
 type Validator struct {
 	field         *k8sfield.Path
 	vmiSpec       *v1.VirtualMachineInstanceSpec
 	configChecker clusterConfigChecker
+	arch          string
+
+	reservedMacRanges               []MacRange
+	strictMacAddressCase            bool
+	warnPlaceholderMacAddress       bool
+	warnForeignHypervisorMacAddress bool
+	annotations                     map[string]string
+	ifaceStatuses                   []v1.VirtualMachineInstanceNetworkInterface
+	clusterIPFamilies               []k8scorev1.IPFamily
+	clusterPodCIDRs                 []string
+	networkToResourceMap            map[string]string
+	resourceRequests                k8scorev1.ResourceList
+	macPoolRemainingCapacity        *int
+	reservedHostPorts               []int32
+
+	maxSecondaryNetworks            int
+	maxNetworkAttachmentDefinitions int
+	maxInterfacePorts               int
+	maxNetworkSpecSize              int
 
 	networkByName map[string]v1.Network
 }
 
-func NewValidator(field *k8sfield.Path, vmiSpec *v1.VirtualMachineInstanceSpec, configChecker clusterConfigChecker) *Validator {
-	return &Validator{
-		field:         field,
-		vmiSpec:       vmiSpec,
-		configChecker: configChecker,
-		networkByName: netvmispec.IndexNetworkSpecByName(vmiSpec.Networks),
+type option func(*Validator)
+
+func NewValidator(
+	field *k8sfield.Path, vmiSpec *v1.VirtualMachineInstanceSpec, configChecker clusterConfigChecker, opts ...option,
+) *Validator {
+	v := &Validator{
+		field:              field,
+		vmiSpec:            vmiSpec,
+		configChecker:      configChecker,
+		maxInterfacePorts:  DefaultMaxInterfacePorts,
+		maxNetworkSpecSize: DefaultMaxNetworkSpecSize,
+	}
+	if vmiSpec != nil {
+		v.networkByName = netvmispec.IndexNetworkSpecByName(vmiSpec.Networks)
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// WithArchitecture sets the VMI architecture, used to restrict architecture specific settings.
+func WithArchitecture(arch string) option {
+	return func(v *Validator) {
+		v.arch = arch
 	}
 }
 
 func (v Validator) Validate() []metav1.StatusCause {
+	if v.vmiSpec == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s is required", v.field.String()),
+			Field:   v.field.String(),
+		}}
+	}
+
+	// Empty interface entries and network names fail most of the checks below, report them alone instead.
+	var earlyCauses []metav1.StatusCause
+	earlyCauses = append(earlyCauses, validateInterfaceNotEmpty(v.field, v.vmiSpec)...)
+	earlyCauses = append(earlyCauses, validateNetworkNameFormat(v.field, v.vmiSpec)...)
+	if len(earlyCauses) > 0 {
+		return earlyCauses
+	}
+
 	var causes []metav1.StatusCause
 
 	causes = append(causes, validateSinglePodNetwork(v.field, v.vmiSpec)...)
+	causes = append(causes, validatePasstSolePodNetworkInterface(v.field, v.vmiSpec)...)
 	causes = append(causes, validateSingleNetworkSource(v.field, v.vmiSpec)...)
 	causes = append(causes, validateMultusNetworkSource(v.field, v.vmiSpec)...)
+	causes = append(causes, validateMultusNetworkName(v.field, v.vmiSpec)...)
+	causes = append(causes, validateNetworkAttachmentDefinitionsCount(v.field, v.vmiSpec, v.maxNetworkAttachmentDefinitions)...)
 	causes = append(causes, validateInterfaceStateValue(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfaceBinding(v.field, v.vmiSpec, v.configChecker)...)
 	causes = append(causes, validateSlirpBinding(v.field, v.vmiSpec, v.configChecker)...)
+	causes = append(causes, validateNetworkAttachmentBindingsCompatible(v.field, v.vmiSpec)...)
 	causes = append(causes, validateNetworkNameUnique(v.field, v.vmiSpec)...)
+	causes = append(causes, validateNetworkNameWhitespace(v.field, v.vmiSpec)...)
 	causes = append(causes, validateNetworksAssignedToInterfaces(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfaceNameUnique(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceResourceSafeNameUnique(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesAssignedToNetworks(v.field, v.vmiSpec)...)
-	causes = append(causes, validateInterfacesFields(v.field, v.vmiSpec)...)
+	causes = append(causes, validateNetworkInterfaceNameCase(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfacesFields(v.field, v.vmiSpec, v.arch)...)
+	causes = append(causes, validateForwardPortsUniqueAcrossInterfaces(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfacePortsCount(v.field, v.vmiSpec, v.maxInterfacePorts)...)
+	causes = append(causes, validateMacAddressNotReserved(v.field, v.vmiSpec, v.reservedMacRanges)...)
+	causes = append(causes, validateMacAddressUniquePerNetworkAttachment(v.field, v.vmiSpec)...)
+	causes = append(causes, validateMacAddressLowercase(v.field, v.vmiSpec, v.strictMacAddressCase)...)
+	causes = append(causes, validateInterfacePciAddressUnique(v.field, v.vmiSpec)...)
+	causes = append(causes, validateMasqueradeCIDRsNotOverlappingPodCIDRs(v.field, v.vmiSpec, v.clusterPodCIDRs)...)
+	causes = append(causes, validateSRIOVResourceRequests(v.field, v.vmiSpec, v.networkToResourceMap, v.resourceRequests)...)
+	causes = append(causes, validateSRIOVResourceNames(v.field, v.vmiSpec, v.networkToResourceMap)...)
+	causes = append(causes, validateSRIOVBootOrder(v.field, v.vmiSpec)...)
 
 	return causes
 }
 
 func (v Validator) ValidateCreation() []metav1.StatusCause {
+	if v.vmiSpec == nil {
+		return nil
+	}
+
 	var causes []metav1.StatusCause
 
 	causes = append(causes, validateCreationSlirpBinding(v.field, v.vmiSpec)...)
 
 	return causes
 }
+
+func (v Validator) ValidateUpdate(oldVMISpec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if v.vmiSpec == nil || oldVMISpec == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+
+	causes = append(causes, validateInterfaceHotplugEnabled(v.field, oldVMISpec, v.vmiSpec, v.configChecker)...)
+	causes = append(causes, validateHotpluggedNetworkNameCollision(v.field, oldVMISpec, v.vmiSpec)...)
+	causes = append(causes, validateRemovedInterfaceNetworks(v.field, oldVMISpec, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceMacAddressUnchanged(v.field, oldVMISpec, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceBindingUnchanged(v.field, oldVMISpec, v.vmiSpec)...)
+	causes = append(causes, validateInterfacePciAddressUnchanged(v.field, oldVMISpec, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceModelChangeAcknowledged(v.field, oldVMISpec, v.vmiSpec, v.annotations)...)
+	causes = append(causes, validateReportedInterfacesRemoval(v.field, oldVMISpec, v.vmiSpec, v.ifaceStatuses)...)
+
+	return causes
+}
+
+// ValidateUpdateWarnings returns causes which do not block the update but are worth reporting back to the user.
+func (v Validator) ValidateUpdateWarnings(oldVMISpec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if v.vmiSpec == nil || oldVMISpec == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+
+	causes = append(causes, validatePodNetworkPositionUnchanged(v.field, oldVMISpec, v.vmiSpec)...)
+
+	return causes
+}
+
+// ValidateWarnings returns causes which do not block the admission but are worth reporting back to the user.
+func (v Validator) ValidateWarnings() []metav1.StatusCause {
+	if v.vmiSpec == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+
+	causes = append(causes, validatePasstWithSlirpBinding(v.field, v.vmiSpec)...)
+	causes = append(causes, validateBridgeOnPodNetwork(v.field, v.vmiSpec)...)
+	causes = append(causes, validateFirstBootInterfaceBinding(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceBootOrderWithKernelBoot(v.field, v.vmiSpec)...)
+	causes = append(causes, validateBootOrderContiguous(v.field, v.vmiSpec)...)
+	causes = append(causes, validatePortsExposableByService(v.field, v.vmiSpec)...)
+	causes = append(causes, validateForwardPortNameAcrossProtocols(v.field, v.vmiSpec)...)
+	causes = append(causes, validateMasqueradePrivilegedPorts(v.field, v.vmiSpec)...)
+	causes = append(causes, validateMasqueradeInfrastructurePorts(v.field, v.vmiSpec)...)
+	causes = append(causes, validateMasqueradeReservedHostPorts(v.field, v.vmiSpec, v.reservedHostPorts)...)
+	causes = append(causes, validateRootBusSlotsForMandatoryDevices(v.field, v.vmiSpec)...)
+	causes = append(causes, validateDefaultNetworkInterfaceACPIIndex(v.field, v.vmiSpec)...)
+	causes = append(causes, validateACPIIndexWithPciAddress(v.field, v.vmiSpec)...)
+	causes = append(causes, validatePodInterfacePciAddressNotOnDiskControllerSlot(v.field, v.vmiSpec)...)
+	causes = append(causes, validatePciAddressLowercase(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceNameNotPredictable(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceNameLength(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceNameNotNumeric(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceModelNotEmulated(v.field, v.vmiSpec)...)
+	causes = append(causes, validateMasqueradeDualStackCIDRs(v.field, v.vmiSpec, v.clusterIPFamilies)...)
+	causes = append(causes, validateMasqueradeDNSNameserversFamilies(v.field, v.vmiSpec)...)
+	causes = append(causes, validateSecondaryNetworksCount(v.field, v.vmiSpec, v.maxSecondaryNetworks)...)
+	causes = append(causes, validateDefaultRouteWithoutPodInterface(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfacesWithoutPodInterfaceAutoattach(v.field, v.vmiSpec)...)
+	causes = append(causes, validateMacAddressNotPlaceholder(v.field, v.vmiSpec, v.warnPlaceholderMacAddress)...)
+	causes = append(causes, validateMacAddressNotForeignHypervisor(v.field, v.vmiSpec, v.warnForeignHypervisorMacAddress)...)
+	causes = append(causes, validateMacPoolCapacity(v.field, v.vmiSpec, v.macPoolRemainingCapacity)...)
+	causes = append(causes, validateNetworkSpecSize(v.field, v.vmiSpec, v.maxNetworkSpecSize)...)
+	causes = append(causes, validateMultiQueueCapacity(v.field, v.vmiSpec)...)
+
+	return causes
+}
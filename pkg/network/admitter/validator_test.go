@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validator", func() {
+	DescribeTable("should treat nil and empty interfaces and networks alike",
+		func(nilSpec, emptySpec *v1.VirtualMachineInstanceSpec, expectedCauses []metav1.StatusCause) {
+			for _, spec := range []*v1.VirtualMachineInstanceSpec{nilSpec, emptySpec} {
+				clusterConfig := stubClusterConfigChecker{hotplugNICsFGEnabled: true}
+				validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+
+				Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+				Expect(validator.ValidateCreation()).To(BeEmpty())
+				Expect(validator.ValidateWarnings()).To(BeEmpty())
+				Expect(validator.ValidateUpdate(&v1.VirtualMachineInstanceSpec{})).To(BeEmpty())
+			}
+		},
+		Entry("without interfaces and networks",
+			&v1.VirtualMachineInstanceSpec{},
+			&v1.VirtualMachineInstanceSpec{
+				Domain:   v1.DomainSpec{Devices: v1.Devices{Interfaces: []v1.Interface{}}},
+				Networks: []v1.Network{},
+			},
+			nil,
+		),
+		Entry("with a network and no interfaces",
+			&v1.VirtualMachineInstanceSpec{Networks: []v1.Network{*v1.DefaultPodNetwork()}},
+			&v1.VirtualMachineInstanceSpec{
+				Domain:   v1.DomainSpec{Devices: v1.Devices{Interfaces: []v1.Interface{}}},
+				Networks: []v1.Network{*v1.DefaultPodNetwork()},
+			},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueRequired",
+				Message: "fake.networks[0].name 'default' not found.",
+				Field:   "fake.networks[0].name",
+			}},
+		),
+		Entry("with an interface and no networks",
+			&v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{Devices: v1.Devices{Interfaces: []v1.Interface{*v1.DefaultBridgeNetworkInterface()}}},
+			},
+			&v1.VirtualMachineInstanceSpec{
+				Domain:   v1.DomainSpec{Devices: v1.Devices{Interfaces: []v1.Interface{*v1.DefaultBridgeNetworkInterface()}}},
+				Networks: []v1.Network{},
+			},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "fake.domain.devices.interfaces[0].name 'default' not found.",
+				Field:   "fake.domain.devices.interfaces[0].name",
+			}},
+		),
+	)
+
+	It("should report a nil spec instead of panicking", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), nil, stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueRequired",
+			Message: "fake is required",
+			Field:   "fake",
+		}))
+		Expect(validator.ValidateCreation()).To(BeEmpty())
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+		Expect(validator.ValidateUpdate(&v1.VirtualMachineInstanceSpec{})).To(BeEmpty())
+	})
+
+	It("should not panic on a nil old spec", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &v1.VirtualMachineInstanceSpec{}, stubClusterConfigChecker{})
+		Expect(validator.ValidateUpdate(nil)).To(BeEmpty())
+	})
+
+	It("should accept a spec with an unset domain", func() {
+		spec := &v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{}}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+		Expect(validator.ValidateWarnings()).To(BeEmpty())
+	})
+
+	It("should report causes in a deterministic order", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{
+			{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}},
+			{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}},
+			{Name: "green", InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}},
+		}
+		spec.Networks = []v1.Network{
+			{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+			{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}},
+			{Name: "yellow", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "yellow-net"}}},
+			{Name: "purple", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "purple-net"}}},
+		}
+		networkToResourceMap := map[string]string{
+			"red":   "example.com/red",
+			"blue":  "example.com/blue",
+			"green": "example.com/green",
+		}
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{},
+			admitter.WithSRIOVResourceRequests(networkToResourceMap, nil),
+		)
+
+		causes := validator.Validate()
+		Expect(len(causes)).To(BeNumerically(">", 1))
+		for i := 0; i < 10; i++ {
+			Expect(validator.Validate()).To(Equal(causes))
+		}
+	})
+
+	DescribeTable("should treat nil and empty interface ports alike", func(iface v1.Interface, network v1.Network) {
+		for _, ports := range [][]v1.Port{nil, {}} {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			iface.Ports = ports
+			spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+			spec.Networks = []v1.Network{network}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(BeEmpty())
+			Expect(validator.ValidateWarnings()).To(BeEmpty())
+		}
+	},
+		Entry("on a masquerade interface", *v1.DefaultMasqueradeNetworkInterface(), *v1.DefaultPodNetwork()),
+		Entry("on an SR-IOV interface",
+			v1.Interface{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}},
+			v1.Network{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+		),
+		Entry("on a bridge interface on a secondary network",
+			v1.Interface{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			v1.Network{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+		),
+	)
+})
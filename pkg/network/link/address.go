@@ -31,7 +31,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
-	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
 const bridgeFakeIP = "169.254.75.1%d/32"
@@ -60,7 +60,7 @@ func GenerateMasqueradeGatewayAndVmIPAddrs(vmiSpecNetwork *v1.Network, ipVersion
 	var cidrToConfigure string
 	if ipVersion == netdriver.IPv4 {
 		if vmiSpecNetwork.Pod.VMNetworkCIDR == "" {
-			cidrToConfigure = api.DefaultVMCIDR
+			cidrToConfigure = vmispec.DefaultVMCIDR
 		} else {
 			cidrToConfigure = vmiSpecNetwork.Pod.VMNetworkCIDR
 		}
@@ -69,7 +69,7 @@ func GenerateMasqueradeGatewayAndVmIPAddrs(vmiSpecNetwork *v1.Network, ipVersion
 
 	if ipVersion == netdriver.IPv6 {
 		if vmiSpecNetwork.Pod.VMIPv6NetworkCIDR == "" {
-			cidrToConfigure = api.DefaultVMIpv6CIDR
+			cidrToConfigure = vmispec.DefaultVMIpv6CIDR
 		} else {
 			cidrToConfigure = vmiSpecNetwork.Pod.VMIPv6NetworkCIDR
 		}
@@ -34,6 +34,12 @@ import (
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 )
 
+// The libvirt migration ports, skipped from forwarding on legacy setups.
+const (
+	LibvirtDirectMigrationPort = 49152
+	LibvirtBlockMigrationPort  = 49153
+)
+
 type nftable interface {
 	AddTable(family nft.IPFamily, name string) error
 	AddChain(family nft.IPFamily, table, name string, chainspec ...string) error
@@ -82,8 +88,6 @@ func WithNftableAdapter(h nftable) option {
 // WithLegacyMigrationPorts is used for legacy setups where migration ports are in use
 // When set, the configuration should skip forwarding for the reserved migration ports.
 func WithLegacyMigrationPorts() option {
-	const LibvirtDirectMigrationPort = 49152
-	const LibvirtBlockMigrationPort = 49153
 	return func(m *MasqPod) {
 		m.migrationPorts = []int{LibvirtDirectMigrationPort, LibvirtBlockMigrationPort}
 	}
@@ -25,6 +25,12 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 )
 
+// The CIDRs the masquerade binding uses for the VM network when the pod network does not set them.
+const (
+	DefaultVMCIDR     = "10.0.2.0/24"
+	DefaultVMIpv6CIDR = "fd10:0:2::/120"
+)
+
 type netClusterConfiger interface {
 	GetDefaultNetworkInterface() string
 	IsBridgeInterfaceOnPodNetworkEnabled() bool
@@ -134,9 +134,14 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	warnings := warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig)
+	for _, cause := range netValidator.ValidateWarnings() {
+		warnings = append(warnings, cause.Message)
+	}
+
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig),
+		Warnings: warnings,
 	}
 }
 
@@ -179,7 +184,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateSpecTopologySpreadConstraints(field, spec)...)
 	causes = append(causes, validateArchitecture(field, spec, config)...)
 
-	netValidator := netadmitter.NewValidator(field, spec, config)
+	netValidator := netadmitter.NewValidator(field, spec, config, netadmitter.WithArchitecture(spec.Architecture))
 	causes = append(causes, netValidator.Validate()...)
 
 	causes = append(causes, validateBootOrder(field, spec, volumeNameMap)...)
@@ -263,6 +268,7 @@ func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v
 
 func validateInterfaceBootOrder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, bootOrderMap map[uint]bool) (causes []metav1.StatusCause) {
 	for idx, iface := range spec.Domain.Devices.Interfaces {
+		// A nil boot order means unset, only set values are compared
 		if iface.BootOrder != nil {
 			order := *iface.BootOrder
 			// Verify boot order is greater than 0, if provided
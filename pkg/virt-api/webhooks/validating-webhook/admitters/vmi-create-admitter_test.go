@@ -4146,6 +4146,31 @@ var _ = Describe("Function getNumberOfPodInterfaces()", func() {
 		Expect(causes).To(HaveLen(1))
 		Expect(causes[0].Field).To(ContainSubstring("bootOrder"))
 	})
+	DescribeTable("interfaces boot order uniqueness", func(firstOrder, secondOrder *uint, expectedFields []string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Networks = []v1.Network{
+			{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+			{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+		}
+		spec.Domain.Devices.Interfaces = []v1.Interface{
+			{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}, BootOrder: firstOrder},
+			{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}, BootOrder: secondOrder},
+		}
+
+		causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), spec, config)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept when both are unset", nil, nil, []string{}),
+		Entry("should accept when only the first is set", pointer.P(uint(1)), nil, []string{}),
+		Entry("should accept when only the second is set", nil, pointer.P(uint(1)), []string{}),
+		Entry("should accept when both are set to different values", pointer.P(uint(1)), pointer.P(uint(2)), []string{}),
+		Entry("should reject when both are set to the same value", pointer.P(uint(1)), pointer.P(uint(1)),
+			[]string{"fake.domain.devices.interfaces[1].bootOrder"}),
+	)
 	It("should reject a serial number whose length is greater than 256", func() {
 		spec := &v1.VirtualMachineInstanceSpec{}
 		sn := strings.Repeat("1", maxStrLen+1)
@@ -31,6 +31,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -415,6 +416,15 @@ func admitHotplug(
 		return response
 	}
 
+	netValidator := netadmitter.NewValidator(
+		k8sfield.NewPath("spec"), &newVMI.Spec, clusterConfig,
+		netadmitter.WithAnnotations(newVMI.Annotations),
+		netadmitter.WithInterfaceStatuses(newVMI.Status.Interfaces),
+	)
+	if causes := netValidator.ValidateUpdate(&oldVMI.Spec); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	return admitStorageUpdate(
 		newVMI.Spec.Volumes,
 		oldVMI.Spec.Volumes,
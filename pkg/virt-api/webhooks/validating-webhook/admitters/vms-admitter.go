@@ -219,6 +219,18 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 	}
 
 	warnings := warnDeprecatedAPIs(&vm.Spec.Template.Spec, admitter.ClusterConfig)
+	netValidator := netadmitter.NewValidator(k8sfield.NewPath("spec", "template", "spec"), &vmCopy.Spec.Template.Spec, admitter.ClusterConfig)
+	for _, cause := range netValidator.ValidateWarnings() {
+		warnings = append(warnings, cause.Message)
+	}
+	if ar.Request.Operation == admissionv1.Update {
+		oldVM := &v1.VirtualMachine{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldVM); err == nil && oldVM.Spec.Template != nil {
+			for _, cause := range netValidator.ValidateUpdateWarnings(&oldVM.Spec.Template.Spec) {
+				warnings = append(warnings, cause.Message)
+			}
+		}
+	}
 	if vm.Spec.Running != nil {
 		warnings = append(warnings, "spec.running is deprecated, please use spec.runStrategy instead.")
 	}
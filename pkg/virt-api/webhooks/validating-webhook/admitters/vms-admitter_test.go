@@ -2532,11 +2532,50 @@ var _ = Describe("Validating VM Admitter", func() {
 		resp := admitVm(vmsAdmitter, vm)
 		Expect(resp.Allowed).To(BeTrue())
 		Expect(resp.Result).To(BeNil())
-		Expect(resp.Warnings).To(HaveLen(2))
+		Expect(resp.Warnings).To(HaveLen(3))
 		Expect(resp.Warnings).To(ConsistOf(
 			HavePrefix("feature gate test-deprecated is deprecated"),
+			HavePrefix("bridge interface \"default\" takes over the pod IP"),
 			HavePrefix("spec.running is deprecated, please use spec.runStrategy instead.")))
 	})
+
+	It("should raise a warning when the pod network moves on update", func() {
+		newVM := func(networks ...v1.Network) *v1.VirtualMachine {
+			vmi := api.NewMinimalVMI("testvmi")
+			for _, network := range networks {
+				iface := v1.Interface{Name: network.Name, InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}}
+				if network.Pod != nil {
+					iface = *v1.DefaultMasqueradeNetworkInterface()
+				}
+				vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, iface)
+			}
+			vmi.Spec.Networks = networks
+			return &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(v1.RunStrategyHalted),
+					Template:    &v1.VirtualMachineInstanceTemplateSpec{Spec: vmi.Spec},
+				},
+			}
+		}
+		redNetwork := v1.Network{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}}
+		oldVMBytes, err := json.Marshal(newVM(*v1.DefaultPodNetwork(), redNetwork))
+		Expect(err).NotTo(HaveOccurred())
+		vmBytes, err := json.Marshal(newVM(redNetwork, *v1.DefaultPodNetwork()))
+		Expect(err).NotTo(HaveOccurred())
+
+		resp := vmsAdmitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Resource:  webhooks.VirtualMachineGroupVersionResource,
+				Object:    runtime.RawExtension{Raw: vmBytes},
+				OldObject: runtime.RawExtension{Raw: oldVMBytes},
+			},
+		})
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Warnings).To(ConsistOf(
+			"pod network \"default\" moved from index 0 to 1, which may change the guest interface names",
+		))
+	})
 })
 
 func admitVm(admitter *VMsAdmitter, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
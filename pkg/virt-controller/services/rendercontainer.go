@@ -2,6 +2,7 @@ package services
 
 import (
 	"strconv"
+	"strings"
 
 	k8sv1 "k8s.io/api/core/v1"
 
@@ -200,7 +201,14 @@ func securityContext(userId int64, privileged bool, requiredCapabilities *k8sv1.
 }
 
 func containerPortsFromVMI(vmi *v1.VirtualMachineInstance) []k8sv1.ContainerPort {
+	type renderedPort struct {
+		name     string
+		protocol string
+		port     int32
+	}
 	var ports []k8sv1.ContainerPort
+	renderedPorts := map[renderedPort]struct{}{}
+	portNames := map[string]struct{}{}
 
 	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
 		if iface.Ports != nil {
@@ -208,6 +216,21 @@ func containerPortsFromVMI(vmi *v1.VirtualMachineInstance) []k8sv1.ContainerPort
 				if port.Protocol == "" {
 					port.Protocol = "TCP"
 				}
+				port.Protocol = strings.ToUpper(port.Protocol)
+
+				key := renderedPort{name: port.Name, protocol: port.Protocol, port: port.Port}
+				if _, exists := renderedPorts[key]; exists {
+					continue
+				}
+				renderedPorts[key] = struct{}{}
+
+				// A port name may be shared by ports of different protocols, but container port names must be unique,
+				// so only the first port keeps it. The admitter warns about such a shared name.
+				if _, exists := portNames[port.Name]; exists {
+					port.Name = ""
+				} else if port.Name != "" {
+					portNames[port.Name] = struct{}{}
+				}
 
 				ports = append(ports, k8sv1.ContainerPort{Protocol: k8sv1.Protocol(port.Protocol), Name: port.Name, ContainerPort: port.Port})
 			}
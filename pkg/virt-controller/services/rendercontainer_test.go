@@ -214,6 +214,39 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	It("should render the port protocol in uppercase", func() {
+		ports := []v1.Port{{Name: "dns", Protocol: "udp", Port: 53}, {Name: "http", Protocol: "Tcp", Port: 80}}
+		specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithPorts(
+			vmiWithInterfaceWithPortAllowList("not-relevant", ports...)))
+
+		Expect(specRenderer.Render(exampleCommand).Ports).To(ConsistOf(
+			k8sv1.ContainerPort{Name: "dns", Protocol: k8sv1.ProtocolUDP, ContainerPort: 53},
+			k8sv1.ContainerPort{Name: "http", Protocol: k8sv1.ProtocolTCP, ContainerPort: 80},
+		))
+	})
+
+	It("should render a port name shared across protocols only once", func() {
+		ports := []v1.Port{{Name: "dns", Port: 53}, {Name: "dns", Protocol: "UDP", Port: 53}}
+		specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithPorts(
+			vmiWithInterfaceWithPortAllowList("not-relevant", ports...)))
+
+		Expect(specRenderer.Render(exampleCommand).Ports).To(ConsistOf(
+			k8sv1.ContainerPort{Name: "dns", Protocol: k8sv1.ProtocolTCP, ContainerPort: 53},
+			k8sv1.ContainerPort{Protocol: k8sv1.ProtocolUDP, ContainerPort: 53},
+		))
+	})
+
+	It("should render a port declared more than once only once", func() {
+		ports := []v1.Port{{Name: "http", Port: 80}, {Name: "http", Protocol: "tcp", Port: 80}, {Name: "https", Port: 443}}
+		specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithPorts(
+			vmiWithInterfaceWithPortAllowList("not-relevant", ports...)))
+
+		Expect(specRenderer.Render(exampleCommand).Ports).To(ConsistOf(
+			k8sv1.ContainerPort{Name: "http", Protocol: k8sv1.ProtocolTCP, ContainerPort: 80},
+			k8sv1.ContainerPort{Name: "https", Protocol: k8sv1.ProtocolTCP, ContainerPort: 443},
+		))
+	})
+
 	Context("container command and arguments", func() {
 		DescribeTable("", func(args ...string) {
 			specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithArgs(args))
@@ -2,8 +2,6 @@ package api
 
 const (
 	DefaultProtocol   = "TCP"
-	DefaultVMCIDR     = "10.0.2.0/24"
-	DefaultVMIpv6CIDR = "fd10:0:2::/120"
 	DefaultBridgeName = "k6t-eth0"
 )
 
@@ -23,8 +23,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 
+	"github.com/distribution/reference"
+
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -111,6 +114,11 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if newKV.Spec.Configuration.NetworkConfiguration != nil {
+		results = append(results, validateNetworkBindingPluginsSidecarImage(
+			field.NewPath("spec", "configuration", "network", "binding"), newKV.Spec.Configuration.NetworkConfiguration.Binding)...)
+	}
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -485,3 +493,28 @@ func validateGuestToRequestHeadroom(ratioStrPtr *string) (causes []metav1.Status
 
 	return
 }
+
+func validateNetworkBindingPluginsSidecarImage(field *field.Path, bindings map[string]v1.InterfaceBindingPlugin) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	bindingNames := make([]string, 0, len(bindings))
+	for bindingName := range bindings {
+		bindingNames = append(bindingNames, bindingName)
+	}
+	sort.Strings(bindingNames)
+
+	for _, bindingName := range bindingNames {
+		sidecarImage := bindings[bindingName].SidecarImage
+		if sidecarImage == "" {
+			continue
+		}
+		if _, err := reference.ParseNormalizedNamed(sidecarImage); err != nil {
+			sidecarImageField := field.Key(bindingName).Child("sidecarImage")
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   sidecarImageField.String(),
+				Message: fmt.Sprintf("%s %q is not a valid image reference: %v", sidecarImageField.String(), sidecarImage, err),
+			})
+		}
+	}
+	return causes
+}
@@ -24,6 +24,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"kubevirt.io/kubevirt/pkg/virt-config/deprecation"
 
@@ -165,6 +166,44 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	Context("with network binding plugins", func() {
+		bindingField := field.NewPath("spec", "configuration", "network", "binding")
+
+		DescribeTable("should accept a well-formed sidecar image", func(sidecarImage string) {
+			bindings := map[string]v1.InterfaceBindingPlugin{"mybinding": {SidecarImage: sidecarImage}}
+			Expect(validateNetworkBindingPluginsSidecarImage(bindingField, bindings)).To(BeEmpty())
+		},
+			Entry("with a name only", "binding-sidecar"),
+			Entry("with a registry and a tag", "quay.io/kubevirt/network-binding-sidecar:v1.3.0"),
+			Entry("with a registry port and a tag", "registry:5000/kubevirt/network-binding-sidecar:devel"),
+			Entry("with a digest",
+				"quay.io/kubevirt/network-binding-sidecar@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+			Entry("with a tag and a digest",
+				"quay.io/kubevirt/network-binding-sidecar:v1.3.0@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+			Entry("without a sidecar image", ""),
+		)
+
+		DescribeTable("should reject a malformed sidecar image", func(sidecarImage, expectedReason string) {
+			bindings := map[string]v1.InterfaceBindingPlugin{"mybinding": {SidecarImage: sidecarImage}}
+			Expect(validateNetworkBindingPluginsSidecarImage(bindingField, bindings)).To(ConsistOf(metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueInvalid,
+				Field: "spec.configuration.network.binding[mybinding].sidecarImage",
+				Message: fmt.Sprintf("spec.configuration.network.binding[mybinding].sidecarImage %q is not a valid image reference: %s",
+					sidecarImage, expectedReason),
+			}))
+		},
+			Entry("with an uppercase repository", "quay.io/KubeVirt/sidecar:v1",
+				"invalid reference format: repository name (KubeVirt/sidecar) must be lowercase",
+			),
+			Entry("with an empty tag", "quay.io/kubevirt/sidecar:", "invalid reference format"),
+			Entry("with a short digest", "quay.io/kubevirt/sidecar@sha256:0123", "invalid reference format"),
+			Entry("with spaces", "quay.io/kubevirt/sidecar image", "invalid reference format"),
+			Entry("with an over-length name",
+				"quay.io/kubevirt/"+strings.Repeat("a", 256), "repository name must not be more than 255 characters"),
+			Entry("with an over-length tag", "quay.io/kubevirt/sidecar:"+strings.Repeat("a", 129), "invalid reference format"),
+		)
+	})
+
 	Context("deprecations", func() {
 		var admitter *KubeVirtUpdateAdmitter
 
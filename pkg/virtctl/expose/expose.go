@@ -327,7 +327,7 @@ func podNetworkPorts(vmiSpec *virtv1.VirtualMachineInstanceSpec) []v1.ServicePor
 			if device.Name == podNetworkName {
 				ports := []v1.ServicePort{}
 				for i, port := range device.Ports {
-					ports = append(ports, v1.ServicePort{Name: fmt.Sprintf("port-%d", i+1), Protocol: v1.Protocol(port.Protocol), Port: port.Port})
+					ports = append(ports, v1.ServicePort{Name: fmt.Sprintf("port-%d", i+1), Protocol: v1.Protocol(strings.ToUpper(port.Protocol)), Port: port.Port})
 				}
 				return ports
 			}
@@ -105,9 +105,9 @@ var istioTests = func(vmType VmType) {
 		// Istio Envoy treats traffic differently for ports declared and undeclared in an associated k8s service.
 		// Having both, declared and undeclared ports specified for VMIs with explicit ports allows to test both cases.
 		explicitPorts = []v1.Port{
-			{Port: svcDeclaredTestPort},
-			{Port: svcUndeclaredTestPort},
-			{Port: sshPort},
+			{Name: "svc-declared", Port: svcDeclaredTestPort},
+			{Name: "svc-undeclared", Port: svcUndeclaredTestPort},
+			{Name: "ssh", Port: sshPort},
 		}
 	)
 	BeforeEach(func() {
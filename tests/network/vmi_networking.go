@@ -41,9 +41,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmici "kubevirt.io/kubevirt/pkg/libvmi/cloudinit"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	kvutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
-	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/tests/console"
 	"kubevirt.io/kubevirt/tests/decorators"
 	"kubevirt.io/kubevirt/tests/exec"
@@ -569,7 +569,7 @@ var _ = SIGDescribe("[rfe_id:694][crit:medium][vendor:cnv-qe@redhat.com][level:c
 				vmnetserver.StartTCPServer(serverVMI, tcpPort, console.LoginToCirros)
 
 				if networkCIDR == "" {
-					networkCIDR = api.DefaultVMCIDR
+					networkCIDR = vmispec.DefaultVMCIDR
 				}
 
 				By("Checking ping (IPv4) to gateway")
@@ -768,7 +768,7 @@ var _ = SIGDescribe("[rfe_id:694][crit:medium][vendor:cnv-qe@redhat.com][level:c
 			}
 
 			configureIpv6 := func(vmi *v1.VirtualMachineInstance) error {
-				networkCIDR := api.DefaultVMIpv6CIDR
+				networkCIDR := vmispec.DefaultVMIpv6CIDR
 
 				err := console.RunCommand(vmi, "dhclient -6 eth0", 30*time.Second)
 				if err != nil {